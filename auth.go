@@ -0,0 +1,650 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/urfave/cli"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// tokensFn is the file in the config dir that contains the hashes of
+	// the api tokens.
+	tokensFn = "tokens.json"
+
+	// adminTokenFn is the file in the config dir that the initial admin
+	// token is written to.
+	adminTokenFn = "admin.token"
+
+	// tokenCookie is the cookie that holds the token of a web ui session.
+	tokenCookie = "circuitbreaker_token"
+
+	// tokenBytes is the number of random bytes of a token.
+	tokenBytes = 32
+)
+
+var (
+	errUnauthenticated = status.Error(
+		codes.Unauthenticated, "missing or invalid api token",
+	)
+
+	errPermissionDenied = status.Error(
+		codes.PermissionDenied, "api token does not grant access",
+	)
+)
+
+var noAuthFlag = cli.BoolFlag{
+	Name: "noauth",
+	Usage: "disable authentication of the grpc and http apis. Only use " +
+		"this if access to the apis is restricted otherwise",
+}
+
+// permission is an operation on the api that a role may be allowed to do.
+type permission string
+
+const (
+	// permissionRead allows reading limits, counters and history.
+	permissionRead permission = "read"
+
+	// permissionWrite allows changing limits, resolving queued htlcs and
+	// importing history.
+	permissionWrite permission = "write"
+
+	// permissionBackup allows reading the complete database.
+	permissionBackup permission = "backup"
+)
+
+// role is the set of permissions that an api token grants.
+type role string
+
+const (
+	// roleViewer can see limits, counters and history.
+	roleViewer role = "viewer"
+
+	// roleOperator can do everything.
+	roleOperator role = "operator"
+)
+
+var rolePermissions = map[role][]permission{
+	roleViewer:   {permissionRead},
+	roleOperator: {permissionRead, permissionWrite, permissionBackup},
+}
+
+func parseRole(str string) (role, error) {
+	r := role(str)
+	if _, ok := rolePermissions[r]; !ok {
+		return "", fmt.Errorf("unknown role %v, use %v or %v", str,
+			roleViewer, roleOperator)
+	}
+
+	return r, nil
+}
+
+// allows returns whether the role grants the permission provided.
+func (r role) allows(required permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == required {
+			return true
+		}
+	}
+
+	return false
+}
+
+// healthMethodPrefix is the prefix of the methods of the grpc health service.
+// Health checks are made by orchestrators that don't have a token, so these
+// methods don't require one.
+var healthMethodPrefix = "/" + grpc_health_v1.Health_ServiceDesc.ServiceName + "/"
+
+// reflectionMethodPrefix is the prefix of the methods of all versions of the
+// grpc reflection service. Reflection only describes the api, so tools like
+// grpcurl can use it without a token.
+const reflectionMethodPrefix = "/grpc.reflection."
+
+// isPublicMethod returns whether a grpc method can be called without a token.
+func isPublicMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, healthMethodPrefix) ||
+		strings.HasPrefix(fullMethod, reflectionMethodPrefix)
+}
+
+// methodPermissions lists the permission that is required to call each grpc
+// method. Methods that are not listed can't be called at all, so new methods
+// need to be added here.
+var methodPermissions = map[string]permission{
+	"/circuitbreaker.Service/GetOverview":             permissionRead,
+	"/circuitbreaker.Service/GetInfo":                 permissionRead,
+	"/circuitbreaker.Service/ListLimits":              permissionRead,
+	"/circuitbreaker.Service/GetPeerDetail":           permissionRead,
+	"/circuitbreaker.Service/ListQueuedHtlcs":         permissionRead,
+	"/circuitbreaker.Service/ListQueueActions":        permissionRead,
+	"/circuitbreaker.Service/ListLimitChanges":        permissionRead,
+	"/circuitbreaker.Service/ListForwardingHistory":   permissionRead,
+	"/circuitbreaker.Service/ExportForwardingHistory": permissionRead,
+	"/circuitbreaker.Service/GetForwardingStats":      permissionRead,
+	"/circuitbreaker.Service/SubscribeHtlcDecisions":  permissionRead,
+	"/circuitbreaker.Service/UpdateLimits":            permissionWrite,
+	"/circuitbreaker.Service/ClearLimits":             permissionWrite,
+	"/circuitbreaker.Service/UpdateDefaultLimit":      permissionWrite,
+	"/circuitbreaker.Service/RevertLimit":             permissionWrite,
+	"/circuitbreaker.Service/ImportForwardingHistory": permissionWrite,
+	"/circuitbreaker.Service/ResolveQueuedHtlc":       permissionWrite,
+	"/circuitbreaker.Service/FlushQueue":              permissionWrite,
+	"/circuitbreaker.Service/Backup":                  permissionBackup,
+}
+
+// tokenEntry is a token in the tokens file. Only the hash of the token is
+// stored.
+type tokenEntry struct {
+	Label   string    `json:"label"`
+	Role    role      `json:"role"`
+	Hash    string    `json:"hash"`
+	Created time.Time `json:"created"`
+}
+
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// loadTokens reads the tokens file. A missing file contains no tokens.
+func loadTokens(path string) ([]tokenEntry, error) {
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return nil, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	var entries []tokenEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("invalid tokens file %v: %w", path, err)
+	}
+
+	return entries, nil
+}
+
+// addToken generates a new token and adds it to the tokens file.
+func addToken(path, label string, tokenRole role) (string, error) {
+	entries, err := loadTokens(path)
+	if err != nil {
+		return "", err
+	}
+
+	for _, entry := range entries {
+		if entry.Label == label {
+			return "", fmt.Errorf("token with label %v already exists",
+				label)
+		}
+	}
+
+	secret := make([]byte, tokenBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(secret)
+
+	entries = append(entries, tokenEntry{
+		Label:   label,
+		Role:    tokenRole,
+		Hash:    hashToken(token),
+		Created: time.Now().UTC(),
+	})
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	// Replace the file atomically, so that the daemon never reads a
+	// partial file.
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return "", err
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// authenticator checks api tokens against the tokens file. Changes to the
+// file take effect without a restart.
+type authenticator struct {
+	path string
+
+	tokens  map[string]tokenEntry
+	modTime time.Time
+	lock    sync.Mutex
+}
+
+func newAuthenticator(path string) (*authenticator, error) {
+	a := &authenticator{
+		path: path,
+	}
+
+	if err := a.refresh(); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// refresh reloads the tokens file if it changed. The caller must not hold
+// the lock.
+func (a *authenticator) refresh() error {
+	var modTime time.Time
+	stat, err := os.Stat(a.path)
+	switch {
+	case err == nil:
+		modTime = stat.ModTime()
+
+	case !errors.Is(err, os.ErrNotExist):
+		return err
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.tokens != nil && modTime.Equal(a.modTime) {
+		return nil
+	}
+
+	entries, err := loadTokens(a.path)
+	if err != nil {
+		return err
+	}
+
+	tokens := make(map[string]tokenEntry, len(entries))
+	for _, entry := range entries {
+		tokens[entry.Hash] = entry
+	}
+
+	a.tokens = tokens
+	a.modTime = modTime
+
+	return nil
+}
+
+// authenticate returns the entry of a token.
+func (a *authenticator) authenticate(token string) (*tokenEntry, error) {
+	if token == "" {
+		return nil, errUnauthenticated
+	}
+
+	if err := a.refresh(); err != nil {
+		log.Errorw("Cannot read tokens file", "path", a.path, "err", err)
+
+		return nil, status.Error(codes.Internal, "cannot read tokens")
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	entry, ok := a.tokens[hashToken(token)]
+	if !ok {
+		return nil, errUnauthenticated
+	}
+
+	return &entry, nil
+}
+
+// authorize verifies that the role of the token grants the permission that is
+// required and returns the entry of the token.
+func (a *authenticator) authorize(token string, required permission) (
+	*tokenEntry, error) {
+
+	entry, err := a.authenticate(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if !entry.Role.allows(required) {
+		return nil, errPermissionDenied
+	}
+
+	return entry, nil
+}
+
+// tokenLabelKey is the context key of the label of the token that a grpc call
+// was authenticated with.
+type tokenLabelKey struct{}
+
+// tokenLabelFromContext returns the label of the token that a grpc call was
+// authenticated with, if any.
+func tokenLabelFromContext(ctx context.Context) (string, bool) {
+	label, ok := ctx.Value(tokenLabelKey{}).(string)
+
+	return label, ok
+}
+
+// tokenFromContext extracts the bearer token from the grpc metadata.
+func tokenFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, value := range md.Get("authorization") {
+		if token, ok := bearerToken(value); ok {
+			return token
+		}
+	}
+
+	return ""
+}
+
+func bearerToken(header string) (string, bool) {
+	const prefix = "bearer "
+
+	if len(header) < len(prefix) ||
+		!strings.EqualFold(header[:len(prefix)], prefix) {
+
+		return "", false
+	}
+
+	return strings.TrimSpace(header[len(prefix):]), true
+}
+
+func (a *authenticator) unaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		if isPublicMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		required, ok := methodPermissions[info.FullMethod]
+		if !ok {
+			return nil, errPermissionDenied
+		}
+
+		entry, err := a.authorize(tokenFromContext(ctx), required)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(
+			context.WithValue(ctx, tokenLabelKey{}, entry.Label), req,
+		)
+	}
+}
+
+func (a *authenticator) streamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		if isPublicMethod(info.FullMethod) {
+			return handler(srv, stream)
+		}
+
+		required, ok := methodPermissions[info.FullMethod]
+		if !ok {
+			return errPermissionDenied
+		}
+
+		entry, err := a.authorize(
+			tokenFromContext(stream.Context()), required,
+		)
+		if err != nil {
+			return err
+		}
+
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = context.WithValue(
+			stream.Context(), tokenLabelKey{}, entry.Label,
+		)
+
+		return handler(srv, wrapped)
+	}
+}
+
+// tokenFromRequest extracts the token from the authorization header or the
+// session cookie of an http request.
+func tokenFromRequest(r *http.Request) string {
+	if token, ok := bearerToken(r.Header.Get("Authorization")); ok {
+		return token
+	}
+
+	if cookie, err := r.Cookie(tokenCookie); err == nil {
+		return cookie.Value
+	}
+
+	return ""
+}
+
+// gatewayMetadata passes the token of the web ui session cookie on to the grpc
+// server. The authorization header is already passed on by the gateway.
+func gatewayMetadata(_ context.Context, r *http.Request) metadata.MD {
+	if r.Header.Get("Authorization") != "" {
+		return nil
+	}
+
+	cookie, err := r.Cookie(tokenCookie)
+	if err != nil {
+		return nil
+	}
+
+	return metadata.Pairs("authorization", "Bearer "+cookie.Value)
+}
+
+// httpHandler wraps an http handler that is served outside of the gateway,
+// and only calls it if the request carries a token with the permission
+// required.
+func (a *authenticator) httpHandler(required permission,
+	handler http.Handler) http.Handler {
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := a.authorize(tokenFromRequest(r), required)
+		switch status.Code(err) {
+		case codes.OK:
+			handler.ServeHTTP(w, r)
+
+		case codes.Unauthenticated:
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+
+		case codes.PermissionDenied:
+			http.Error(w, err.Error(), http.StatusForbidden)
+
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+type loginRequest struct {
+	Token string `json:"token"`
+}
+
+type sessionResponse struct {
+	Role role `json:"role"`
+}
+
+// newLoginHandler returns the handler that starts a web ui session. The token
+// is stored in an http-only cookie, so that it is also sent along with
+// downloads and event streams.
+func (a *authenticator) newLoginHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed",
+				http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		var req loginRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		entry, err := a.authenticate(req.Token)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{
+			Name:     tokenCookie,
+			Value:    req.Token,
+			Path:     "/",
+			HttpOnly: true,
+			Secure:   r.TLS != nil,
+			SameSite: http.SameSiteStrictMode,
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&sessionResponse{Role: entry.Role})
+	})
+}
+
+// newSessionHandler returns the handler that reports the role of the web ui
+// session, so that the web ui can hide what the role doesn't allow. Without
+// authentication, everything is allowed.
+func newSessionHandler(a *authenticator) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed",
+				http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		sessionRole := roleOperator
+		if a != nil {
+			entry, err := a.authenticate(tokenFromRequest(r))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+
+				return
+			}
+
+			sessionRole = entry.Role
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&sessionResponse{Role: sessionRole})
+	})
+}
+
+// newLogoutHandler returns the handler that ends a web ui session.
+func newLogoutHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed",
+				http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{
+			Name:     tokenCookie,
+			Path:     "/",
+			MaxAge:   -1,
+			HttpOnly: true,
+			SameSite: http.SameSiteStrictMode,
+		})
+	})
+}
+
+// initAuth sets up authentication for the daemon. If there are no tokens yet,
+// an admin token is generated and written to the config dir.
+func initAuth(confDir string) (*authenticator, error) {
+	tokensPath := filepath.Join(confDir, tokensFn)
+
+	entries, err := loadTokens(tokensPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		token, err := addToken(tokensPath, "admin", roleOperator)
+		if err != nil {
+			return nil, err
+		}
+
+		adminTokenPath := filepath.Join(confDir, adminTokenFn)
+		err = os.WriteFile(adminTokenPath, []byte(token+"\n"), 0600)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infow("Generated admin api token", "path", adminTokenPath)
+	}
+
+	return newAuthenticator(tokensPath)
+}
+
+var genTokenCommand = cli.Command{
+	Name:  "gentoken",
+	Usage: "generate an api token",
+	Description: "Generates a token for the grpc and http apis and prints " +
+		"it. The token is only shown once, circuitbreaker only stores " +
+		"its hash. Pass the token as 'Authorization: Bearer <token>' or " +
+		"log in with it in the web ui. A running circuitbreaker " +
+		"accepts new tokens immediately. To revoke a token, remove it " +
+		"from " + tokensFn + " in the config dir.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "role",
+			Value: string(roleViewer),
+			Usage: "role that the token grants: " +
+				string(roleViewer) + " or " + string(roleOperator),
+		},
+		cli.StringFlag{
+			Name:  "label",
+			Usage: "unique name that identifies the token",
+		},
+	},
+	Action: genToken,
+}
+
+func genToken(c *cli.Context) error {
+	tokenRole, err := parseRole(c.String("role"))
+	if err != nil {
+		return err
+	}
+
+	label := c.String("label")
+	if label == "" {
+		return errors.New("label required")
+	}
+
+	confDir := c.GlobalString("configdir")
+	if err := os.MkdirAll(confDir, os.ModePerm); err != nil {
+		return err
+	}
+
+	token, err := addToken(filepath.Join(confDir, tokensFn), label, tokenRole)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(token)
+
+	return nil
+}
@@ -0,0 +1,295 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lightningequipment/circuitbreaker/circuitbreakerrpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestInitAuth(t *testing.T) {
+	confDir := t.TempDir()
+
+	auth, err := initAuth(confDir)
+	require.NoError(t, err)
+
+	// An admin token is generated on first start.
+	data, err := os.ReadFile(filepath.Join(confDir, adminTokenFn))
+	require.NoError(t, err)
+	adminToken := strings.TrimSpace(string(data))
+
+	entry, err := auth.authenticate(adminToken)
+	require.NoError(t, err)
+	require.Equal(t, roleOperator, entry.Role)
+
+	// Only the hash of the token is stored.
+	data, err = os.ReadFile(filepath.Join(confDir, tokensFn))
+	require.NoError(t, err)
+	require.NotContains(t, string(data), adminToken)
+
+	// Restarting doesn't generate another token.
+	require.NoError(t, os.Remove(filepath.Join(confDir, adminTokenFn)))
+	_, err = initAuth(confDir)
+	require.NoError(t, err)
+	require.NoFileExists(t, filepath.Join(confDir, adminTokenFn))
+
+	// Tokens that are added later are accepted without a restart.
+	tokensPath := filepath.Join(confDir, tokensFn)
+	token, err := addToken(tokensPath, "dashboard", roleViewer)
+	require.NoError(t, err)
+
+	// Make sure that the change is detected on file systems with a coarse
+	// modification time.
+	later := time.Now().Add(time.Second)
+	require.NoError(t, os.Chtimes(tokensPath, later, later))
+
+	entry, err = auth.authenticate(token)
+	require.NoError(t, err)
+	require.Equal(t, roleViewer, entry.Role)
+	require.Equal(t, "dashboard", entry.Label)
+
+	_, err = addToken(tokensPath, "dashboard", roleOperator)
+	require.ErrorContains(t, err, "already exists")
+
+	_, err = auth.authenticate("invalid")
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = auth.authenticate("")
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// testStream is a server stream that only carries a context.
+type testStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestAuthInterceptors(t *testing.T) {
+	tokensPath := filepath.Join(t.TempDir(), tokensFn)
+
+	operatorToken, err := addToken(tokensPath, "operator", roleOperator)
+	require.NoError(t, err)
+
+	viewerToken, err := addToken(tokensPath, "viewer", roleViewer)
+	require.NoError(t, err)
+
+	auth, err := newAuthenticator(tokensPath)
+	require.NoError(t, err)
+
+	unaryInterceptor := auth.unaryServerInterceptor()
+	streamInterceptor := auth.streamServerInterceptor()
+
+	desc := circuitbreakerrpc.Service_ServiceDesc
+	streams := make(map[string]bool)
+	for _, stream := range desc.Streams {
+		streams["/"+desc.ServiceName+"/"+stream.StreamName] = true
+	}
+
+	// call invokes the interceptor that applies to the method. The handler
+	// records the identity of the caller.
+	var caller string
+	call := func(token, method string) error {
+		ctx := context.Background()
+		if token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(
+				"authorization", "Bearer "+token,
+			))
+		}
+
+		if streams[method] {
+			return streamInterceptor(
+				nil, &testStream{ctx: ctx},
+				&grpc.StreamServerInfo{FullMethod: method},
+				func(_ interface{}, stream grpc.ServerStream) error {
+					caller = callerIdentity(stream.Context())
+
+					return nil
+				},
+			)
+		}
+
+		_, err := unaryInterceptor(
+			ctx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, _ interface{}) (interface{},
+				error) {
+
+				caller = callerIdentity(ctx)
+
+				return "ok", nil
+			},
+		)
+
+		return err
+	}
+
+	// viewerMethods are the methods that viewers can call. Operators can
+	// call every method.
+	viewerMethods := map[string]bool{
+		"GetOverview":             true,
+		"GetInfo":                 true,
+		"ListLimits":              true,
+		"GetPeerDetail":           true,
+		"ListQueuedHtlcs":         true,
+		"ListQueueActions":        true,
+		"ListLimitChanges":        true,
+		"ListForwardingHistory":   true,
+		"ExportForwardingHistory": true,
+		"GetForwardingStats":      true,
+		"SubscribeHtlcDecisions":  true,
+	}
+
+	names := make([]string, 0, len(desc.Methods)+len(desc.Streams))
+	for _, method := range desc.Methods {
+		names = append(names, method.MethodName)
+	}
+	for _, stream := range desc.Streams {
+		names = append(names, stream.StreamName)
+	}
+
+	for _, name := range names {
+		method := "/" + desc.ServiceName + "/" + name
+
+		t.Run(name, func(t *testing.T) {
+			require.Contains(t, methodPermissions, method)
+
+			require.NoError(t, call(operatorToken, method))
+
+			err := call(viewerToken, method)
+			if viewerMethods[name] {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, codes.PermissionDenied,
+					status.Code(err))
+			}
+
+			require.Equal(t, codes.Unauthenticated,
+				status.Code(call("", method)))
+			require.Equal(t, codes.Unauthenticated,
+				status.Code(call("invalid", method)))
+		})
+	}
+
+	// The label of the token identifies the caller.
+	require.NoError(t, call(operatorToken, "/circuitbreaker.Service/FlushQueue"))
+	require.Equal(t, "operator (unknown)", caller)
+
+	require.NoError(t, call(
+		viewerToken, "/circuitbreaker.Service/SubscribeHtlcDecisions",
+	))
+	require.Equal(t, "viewer (unknown)", caller)
+
+	// Every permission refers to an existing method.
+	require.Len(t, methodPermissions, len(names))
+
+	// Methods that are unknown can't be called.
+	require.Equal(t, codes.PermissionDenied,
+		status.Code(call(operatorToken, "/circuitbreaker.Service/New")))
+
+	// Health checks don't require a token.
+	require.NoError(t, call("", "/grpc.health.v1.Health/Check"))
+	streams["/grpc.health.v1.Health/Watch"] = true
+	require.NoError(t, call("", "/grpc.health.v1.Health/Watch"))
+
+	// Neither does reflection.
+	for _, method := range []string{
+		"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo",
+		"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo",
+	} {
+		streams[method] = true
+		require.NoError(t, call("", method))
+	}
+}
+
+func TestAuthHttp(t *testing.T) {
+	tokensPath := filepath.Join(t.TempDir(), tokensFn)
+
+	token, err := addToken(tokensPath, "readonly", roleViewer)
+	require.NoError(t, err)
+
+	auth, err := newAuthenticator(tokensPath)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.Handle("/api/login", auth.newLoginHandler())
+	mux.Handle("/api/session", newSessionHandler(auth))
+	mux.Handle("/read", auth.httpHandler(permissionRead, http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {},
+	)))
+	mux.Handle("/write", auth.httpHandler(permissionWrite, http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {},
+	)))
+
+	serve := func(r *http.Request) *http.Response {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+
+		return w.Result()
+	}
+
+	resp := serve(httptest.NewRequest(http.MethodGet, "/read", nil))
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	req := httptest.NewRequest(http.MethodGet, "/read", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	require.Equal(t, http.StatusOK, serve(req).StatusCode)
+
+	req = httptest.NewRequest(http.MethodGet, "/write", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	require.Equal(t, http.StatusForbidden, serve(req).StatusCode)
+
+	// Logging in with an invalid token fails.
+	resp = serve(httptest.NewRequest(
+		http.MethodPost, "/api/login",
+		strings.NewReader(`{"token":"invalid"}`),
+	))
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	require.Empty(t, resp.Cookies())
+
+	// Logging in sets the session cookie, which is accepted in place of
+	// the authorization header.
+	resp = serve(httptest.NewRequest(
+		http.MethodPost, "/api/login",
+		strings.NewReader(`{"token":"`+token+`"}`),
+	))
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	cookies := resp.Cookies()
+	require.Len(t, cookies, 1)
+	require.Equal(t, tokenCookie, cookies[0].Name)
+	require.True(t, cookies[0].HttpOnly)
+
+	req = httptest.NewRequest(http.MethodGet, "/read", nil)
+	req.AddCookie(cookies[0])
+	require.Equal(t, http.StatusOK, serve(req).StatusCode)
+
+	// The session reports the role of the token.
+	sessionReq := httptest.NewRequest(http.MethodGet, "/api/session", nil)
+	sessionReq.AddCookie(cookies[0])
+	resp = serve(sessionReq)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var session sessionResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&session))
+	require.Equal(t, roleViewer, session.Role)
+
+	// The gateway passes the cookie on as grpc metadata.
+	md := gatewayMetadata(context.Background(), req)
+	require.Equal(t, []string{"Bearer " + token}, md.Get("authorization"))
+}
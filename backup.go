@@ -0,0 +1,342 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/lightningequipment/circuitbreaker/circuitbreakerrpc"
+	migrate "github.com/rubenv/sql-migrate"
+	"github.com/urfave/cli"
+)
+
+// backupChunkSize is the size of the chunks in which the Backup rpc streams a
+// snapshot.
+const backupChunkSize = 1024 * 1024
+
+var errBackupUnsupported = errors.New("backups are only supported with the " +
+	"sqlite backend, use pg_dump for postgres")
+
+// vacuumInto writes a consistent snapshot of a sqlite database to a new file.
+// It can run while other connections write to the database.
+func vacuumInto(ctx context.Context, db *sql.DB, path string) error {
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("backup file %v already exists", path)
+	}
+
+	_, err := db.ExecContext(ctx, "VACUUM INTO ?", path)
+	if err != nil {
+		return fmt.Errorf("cannot back up database: %w", err)
+	}
+
+	return nil
+}
+
+// Backup writes a consistent snapshot of the database to a new file.
+func (d *Db) Backup(ctx context.Context, path string) error {
+	if d.backend != backendSqlite {
+		return errBackupUnsupported
+	}
+
+	return vacuumInto(ctx, d.db, path)
+}
+
+func (s *server) Backup(_ *circuitbreakerrpc.BackupRequest,
+	stream circuitbreakerrpc.Service_BackupServer) error {
+
+	ctx := stream.Context()
+
+	dir, err := os.MkdirTemp("", "circuitbreaker-backup-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, dbFn)
+	if err := s.db.Backup(ctx, path); err != nil {
+		return err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	buf := make([]byte, backupChunkSize)
+	for {
+		n, err := file.Read(buf)
+		switch {
+		case err == io.EOF:
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		err = stream.Send(&circuitbreakerrpc.BackupChunk{Data: buf[:n]})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// openSqliteReadOnly opens a sqlite database without applying migrations.
+func openSqliteReadOnly(path string) (*sql.DB, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+
+	return sql.Open(
+		"sqlite", "file:"+path+"?mode=ro&_pragma=busy_timeout=5000",
+	)
+}
+
+// checkBackup verifies the integrity of a backup and that its migration
+// version is supported by this version of circuitbreaker. The applied
+// migration version is returned.
+func checkBackup(ctx context.Context, path string) (int, error) {
+	db, err := openSqliteReadOnly(path)
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	var result string
+	err = db.QueryRowContext(ctx, "PRAGMA integrity_check").Scan(&result)
+	if err != nil {
+		return 0, fmt.Errorf("cannot read backup: %w", err)
+	}
+	if result != "ok" {
+		return 0, fmt.Errorf("backup is corrupt: %v", result)
+	}
+
+	records, err := migrate.GetMigrationRecords(db, "sqlite3")
+	if err != nil {
+		return 0, fmt.Errorf("cannot read migrations of backup: %w", err)
+	}
+	if len(records) == 0 {
+		return 0, errors.New("backup is not a circuitbreaker database")
+	}
+
+	known := make(map[string]struct{}, len(migrations.Migrations))
+	for _, migration := range migrations.Migrations {
+		known[migration.Id] = struct{}{}
+	}
+
+	var version int
+	for _, record := range records {
+		if _, ok := known[record.Id]; !ok {
+			return 0, fmt.Errorf("backup contains migration %v, "+
+				"which is unknown to this version of "+
+				"circuitbreaker", record.Id)
+		}
+
+		id, err := strconv.Atoi(record.Id)
+		if err != nil {
+			return 0, err
+		}
+		if id > version {
+			version = id
+		}
+	}
+
+	return version, nil
+}
+
+// copyFile copies a file to a new file and flushes it to disk.
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+
+		return err
+	}
+
+	if err := out.Sync(); err != nil {
+		out.Close()
+
+		return err
+	}
+
+	return out.Close()
+}
+
+// daemonDialTimeout is the time that restore waits for a connection to the
+// listen addresses of a running circuitbreaker.
+const daemonDialTimeout = time.Second
+
+// checkDaemonStopped returns an error if any of the addresses provided accepts
+// connections. The journal of the database only exists while it is being
+// written, so the listen addresses of circuitbreaker are used to detect that
+// it is running.
+func checkDaemonStopped(addrs ...string) error {
+	for _, addr := range addrs {
+		conn, err := net.DialTimeout("tcp", addr, daemonDialTimeout)
+		if err != nil {
+			continue
+		}
+		conn.Close()
+
+		return fmt.Errorf("%v accepts connections, stop circuitbreaker "+
+			"before restoring", addr)
+	}
+
+	return nil
+}
+
+// restoreDb replaces the database in the config dir with a backup. The current
+// database is kept next to it. The path of the kept database is returned, or
+// an empty string if there was no database yet.
+func restoreDb(ctx context.Context, confDir, backupPath string) (string,
+	error) {
+
+	version, err := checkBackup(ctx, backupPath)
+	if err != nil {
+		return "", err
+	}
+
+	dbPath := filepath.Join(confDir, dbFn)
+
+	// A journal indicates that the database is in use or wasn't closed
+	// cleanly. Replacing the database would lose or corrupt the changes in
+	// the journal.
+	for _, suffix := range []string{"-journal", "-wal"} {
+		if _, err := os.Stat(dbPath + suffix); err == nil {
+			return "", fmt.Errorf("%v exists, stop circuitbreaker "+
+				"before restoring", dbPath+suffix)
+		}
+	}
+
+	// Copy the backup next to the database first, so that the database is
+	// replaced with a single rename.
+	tmpPath := dbPath + ".restore"
+	if err := copyFile(backupPath, tmpPath); err != nil {
+		return "", err
+	}
+
+	var keptPath string
+	if _, err := os.Stat(dbPath); err == nil {
+		keptPath = fmt.Sprintf(
+			"%v.%v.old", dbPath, time.Now().Format("20060102150405"),
+		)
+
+		if err := os.Rename(dbPath, keptPath); err != nil {
+			os.Remove(tmpPath)
+
+			return "", err
+		}
+	}
+
+	if err := os.Rename(tmpPath, dbPath); err != nil {
+		return "", err
+	}
+
+	log.Infow("Database restored", "path", dbPath, "backup", backupPath,
+		"migrationVersion", version)
+
+	return keptPath, nil
+}
+
+var backupCommand = cli.Command{
+	Name:      "backup",
+	Usage:     "write a consistent snapshot of the database to a file",
+	ArgsUsage: "<backup file>",
+	Description: "Takes a snapshot of the sqlite database using VACUUM " +
+		"INTO. The snapshot is consistent, also while circuitbreaker " +
+		"is running. The backup file must not exist yet.",
+	Action: backup,
+}
+
+func backup(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return errors.New("backup file required")
+	}
+
+	if dbBackend(c.GlobalString(dbBackendFlag.Name)) != backendSqlite {
+		return errBackupUnsupported
+	}
+
+	backupPath := cleanAndExpandPath(c.Args().First())
+	dbPath := filepath.Join(c.GlobalString("configdir"), dbFn)
+
+	db, err := openSqliteReadOnly(dbPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if err := vacuumInto(context.Background(), db, backupPath); err != nil {
+		return err
+	}
+
+	log.Infow("Database backed up", "path", dbPath, "backup", backupPath)
+
+	return nil
+}
+
+var restoreCommand = cli.Command{
+	Name:      "restore",
+	Usage:     "replace the database with a backup",
+	ArgsUsage: "<backup file>",
+	Description: "Verifies the backup and replaces the sqlite database " +
+		"with it. Backups that were made by a newer version of " +
+		"circuitbreaker are rejected. The current database is kept " +
+		"next to it. Stop circuitbreaker before restoring, restore " +
+		"refuses to run while the listen addresses accept " +
+		"connections.",
+	Action: restore,
+}
+
+func restore(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return errors.New("backup file required")
+	}
+
+	if dbBackend(c.GlobalString(dbBackendFlag.Name)) != backendSqlite {
+		return errBackupUnsupported
+	}
+
+	err := checkDaemonStopped(
+		c.GlobalString("listen"), c.GlobalString(httpListenFlag.Name),
+	)
+	if err != nil {
+		return err
+	}
+
+	confDir := c.GlobalString("configdir")
+	if err := os.MkdirAll(confDir, os.ModePerm); err != nil {
+		return err
+	}
+
+	keptPath, err := restoreDb(
+		context.Background(), confDir,
+		cleanAndExpandPath(c.Args().First()),
+	)
+	if err != nil {
+		return err
+	}
+
+	if keptPath != "" {
+		log.Infow("Previous database kept", "path", keptPath)
+	}
+
+	return nil
+}
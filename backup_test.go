@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lightningequipment/circuitbreaker/circuitbreakerrpc"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+type backupStreamMock struct {
+	grpc.ServerStream
+
+	ctx  context.Context
+	data bytes.Buffer
+}
+
+func (b *backupStreamMock) Context() context.Context {
+	return b.ctx
+}
+
+func (b *backupStreamMock) Send(chunk *circuitbreakerrpc.BackupChunk) error {
+	_, err := b.data.Write(chunk.Data)
+
+	return err
+}
+
+func TestBackupRestore(t *testing.T) {
+	ctx := context.Background()
+
+	db, cleanup := setupTestDb(t, defaultFwdHistoryLimit)
+	defer cleanup()
+
+	require.NoError(t, db.InitNode(ctx, mockIdentity, false))
+
+	peer := route.Vertex{2}
+	limit := Limit{MaxHourlyRate: 60, MaxPending: 3, Mode: ModeBlock}
+	require.NoError(t, db.UpdateLimit(ctx, mockIdentity, peer, limit, "test"))
+
+	// Take a backup through the rpc.
+	s := NewServer(zap.NewNop().Sugar(), nil, db)
+	stream := &backupStreamMock{ctx: ctx}
+	require.NoError(t, s.Backup(&circuitbreakerrpc.BackupRequest{}, stream))
+
+	backupPath := filepath.Join(t.TempDir(), "backup.db")
+	require.NoError(t, os.WriteFile(backupPath, stream.data.Bytes(), 0600))
+
+	// Existing backup files are not overwritten.
+	require.Error(t, db.Backup(ctx, backupPath))
+
+	// Changes after the backup are undone by restoring it.
+	require.NoError(t, db.UpdateLimit(
+		ctx, mockIdentity, peer, Limit{MaxPending: 1}, "test",
+	))
+
+	// Use a snapshot of the current state as the database to restore over.
+	confDir := t.TempDir()
+	dbPath := filepath.Join(confDir, dbFn)
+	require.NoError(t, db.Backup(ctx, dbPath))
+
+	keptPath, err := restoreDb(ctx, confDir, backupPath)
+	require.NoError(t, err)
+
+	assertLimit := func(path string, expected Limit) {
+		restored, err := NewDb(ctx, path, defaultFwdHistoryLimit)
+		require.NoError(t, err)
+		defer restored.Close()
+
+		limits, err := restored.GetLimits(ctx, mockIdentity)
+		require.NoError(t, err)
+		require.Equal(t, expected, limits.PerPeer[peer])
+	}
+
+	assertLimit(dbPath, limit)
+	assertLimit(keptPath, Limit{MaxPending: 1})
+
+	// The backup command reads the database without migrating it.
+	readOnly, err := openSqliteReadOnly(dbPath)
+	require.NoError(t, err)
+
+	commandBackupPath := filepath.Join(t.TempDir(), "command.db")
+	require.NoError(t, vacuumInto(ctx, readOnly, commandBackupPath))
+	require.NoError(t, readOnly.Close())
+	assertLimit(commandBackupPath, limit)
+
+	// A backup of a newer version of circuitbreaker is rejected.
+	newer, err := sql.Open("sqlite", backupPath)
+	require.NoError(t, err)
+	_, err = newer.Exec(`INSERT INTO gorp_migrations (id, applied_at)
+		VALUES ('1000', CURRENT_TIMESTAMP)`)
+	require.NoError(t, err)
+	require.NoError(t, newer.Close())
+
+	_, err = restoreDb(ctx, confDir, backupPath)
+	require.ErrorContains(t, err, "unknown to this version")
+	assertLimit(dbPath, limit)
+
+	// Files that aren't databases are rejected.
+	invalidPath := filepath.Join(t.TempDir(), "invalid.db")
+	require.NoError(t, os.WriteFile(invalidPath, []byte("invalid"), 0600))
+
+	_, err = restoreDb(ctx, confDir, invalidPath)
+	require.Error(t, err)
+}
+
+func TestCheckDaemonStopped(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	addr := listener.Addr().String()
+	require.ErrorContains(
+		t, checkDaemonStopped("127.0.0.1:0", addr), "stop circuitbreaker",
+	)
+
+	require.NoError(t, listener.Close())
+	require.NoError(t, checkDaemonStopped(addr))
+}
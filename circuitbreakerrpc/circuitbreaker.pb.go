@@ -73,10 +73,469 @@ func (Mode) EnumDescriptor() ([]byte, []int) {
 	return file_circuitbreaker_proto_rawDescGZIP(), []int{0}
 }
 
+type LimitSource int32
+
+const (
+	// The default limit applies to the peer.
+	LimitSource_LIMIT_SOURCE_DEFAULT LimitSource = 0
+	// The peer has a limit of its own.
+	LimitSource_LIMIT_SOURCE_PEER LimitSource = 1
+)
+
+// Enum value maps for LimitSource.
+var (
+	LimitSource_name = map[int32]string{
+		0: "LIMIT_SOURCE_DEFAULT",
+		1: "LIMIT_SOURCE_PEER",
+	}
+	LimitSource_value = map[string]int32{
+		"LIMIT_SOURCE_DEFAULT": 0,
+		"LIMIT_SOURCE_PEER":    1,
+	}
+)
+
+func (x LimitSource) Enum() *LimitSource {
+	p := new(LimitSource)
+	*p = x
+	return p
+}
+
+func (x LimitSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LimitSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_circuitbreaker_proto_enumTypes[1].Descriptor()
+}
+
+func (LimitSource) Type() protoreflect.EnumType {
+	return &file_circuitbreaker_proto_enumTypes[1]
+}
+
+func (x LimitSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LimitSource.Descriptor instead.
+func (LimitSource) EnumDescriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{1}
+}
+
+type ChannelState int32
+
+const (
+	ChannelState_CHANNEL_STATE_ACTIVE ChannelState = 0
+	// The channel is open, but the peer is offline or the channel is
+	// disabled.
+	ChannelState_CHANNEL_STATE_INACTIVE ChannelState = 1
+	ChannelState_CHANNEL_STATE_CLOSED   ChannelState = 2
+)
+
+// Enum value maps for ChannelState.
+var (
+	ChannelState_name = map[int32]string{
+		0: "CHANNEL_STATE_ACTIVE",
+		1: "CHANNEL_STATE_INACTIVE",
+		2: "CHANNEL_STATE_CLOSED",
+	}
+	ChannelState_value = map[string]int32{
+		"CHANNEL_STATE_ACTIVE":   0,
+		"CHANNEL_STATE_INACTIVE": 1,
+		"CHANNEL_STATE_CLOSED":   2,
+	}
+)
+
+func (x ChannelState) Enum() *ChannelState {
+	p := new(ChannelState)
+	*p = x
+	return p
+}
+
+func (x ChannelState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChannelState) Descriptor() protoreflect.EnumDescriptor {
+	return file_circuitbreaker_proto_enumTypes[2].Descriptor()
+}
+
+func (ChannelState) Type() protoreflect.EnumType {
+	return &file_circuitbreaker_proto_enumTypes[2]
+}
+
+func (x ChannelState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChannelState.Descriptor instead.
+func (ChannelState) EnumDescriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{2}
+}
+
+type QueueAction int32
+
+const (
+	// Requests must set the action explicitly.
+	QueueAction_QUEUE_ACTION_UNKNOWN QueueAction = 0
+	QueueAction_QUEUE_ACTION_FORWARD QueueAction = 1
+	QueueAction_QUEUE_ACTION_FAIL    QueueAction = 2
+)
+
+// Enum value maps for QueueAction.
+var (
+	QueueAction_name = map[int32]string{
+		0: "QUEUE_ACTION_UNKNOWN",
+		1: "QUEUE_ACTION_FORWARD",
+		2: "QUEUE_ACTION_FAIL",
+	}
+	QueueAction_value = map[string]int32{
+		"QUEUE_ACTION_UNKNOWN": 0,
+		"QUEUE_ACTION_FORWARD": 1,
+		"QUEUE_ACTION_FAIL":    2,
+	}
+)
+
+func (x QueueAction) Enum() *QueueAction {
+	p := new(QueueAction)
+	*p = x
+	return p
+}
+
+func (x QueueAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueueAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_circuitbreaker_proto_enumTypes[3].Descriptor()
+}
+
+func (QueueAction) Type() protoreflect.EnumType {
+	return &file_circuitbreaker_proto_enumTypes[3]
+}
+
+func (x QueueAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueueAction.Descriptor instead.
+func (QueueAction) EnumDescriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{3}
+}
+
+type SettledFilter int32
+
+const (
+	SettledFilter_SETTLED_FILTER_ALL     SettledFilter = 0
+	SettledFilter_SETTLED_FILTER_SETTLED SettledFilter = 1
+	SettledFilter_SETTLED_FILTER_FAILED  SettledFilter = 2
+)
+
+// Enum value maps for SettledFilter.
+var (
+	SettledFilter_name = map[int32]string{
+		0: "SETTLED_FILTER_ALL",
+		1: "SETTLED_FILTER_SETTLED",
+		2: "SETTLED_FILTER_FAILED",
+	}
+	SettledFilter_value = map[string]int32{
+		"SETTLED_FILTER_ALL":     0,
+		"SETTLED_FILTER_SETTLED": 1,
+		"SETTLED_FILTER_FAILED":  2,
+	}
+)
+
+func (x SettledFilter) Enum() *SettledFilter {
+	p := new(SettledFilter)
+	*p = x
+	return p
+}
+
+func (x SettledFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SettledFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_circuitbreaker_proto_enumTypes[4].Descriptor()
+}
+
+func (SettledFilter) Type() protoreflect.EnumType {
+	return &file_circuitbreaker_proto_enumTypes[4]
+}
+
+func (x SettledFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SettledFilter.Descriptor instead.
+func (SettledFilter) EnumDescriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{4}
+}
+
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_ASCENDING  SortOrder = 0
+	SortOrder_SORT_ORDER_DESCENDING SortOrder = 1
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_ASCENDING",
+		1: "SORT_ORDER_DESCENDING",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_ASCENDING":  0,
+		"SORT_ORDER_DESCENDING": 1,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_circuitbreaker_proto_enumTypes[5].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_circuitbreaker_proto_enumTypes[5]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{5}
+}
+
+type ForwardSource int32
+
+const (
+	// Recorded by circuitbreaker when the htlc resolved.
+	ForwardSource_FORWARD_SOURCE_LIVE ForwardSource = 0
+	// Imported from lnd's forwarding log.
+	ForwardSource_FORWARD_SOURCE_LND ForwardSource = 1
+)
+
+// Enum value maps for ForwardSource.
+var (
+	ForwardSource_name = map[int32]string{
+		0: "FORWARD_SOURCE_LIVE",
+		1: "FORWARD_SOURCE_LND",
+	}
+	ForwardSource_value = map[string]int32{
+		"FORWARD_SOURCE_LIVE": 0,
+		"FORWARD_SOURCE_LND":  1,
+	}
+)
+
+func (x ForwardSource) Enum() *ForwardSource {
+	p := new(ForwardSource)
+	*p = x
+	return p
+}
+
+func (x ForwardSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ForwardSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_circuitbreaker_proto_enumTypes[6].Descriptor()
+}
+
+func (ForwardSource) Type() protoreflect.EnumType {
+	return &file_circuitbreaker_proto_enumTypes[6]
+}
+
+func (x ForwardSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ForwardSource.Descriptor instead.
+func (ForwardSource) EnumDescriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{6}
+}
+
+type Granularity int32
+
+const (
+	Granularity_GRANULARITY_HOUR Granularity = 0
+	Granularity_GRANULARITY_DAY  Granularity = 1
+)
+
+// Enum value maps for Granularity.
+var (
+	Granularity_name = map[int32]string{
+		0: "GRANULARITY_HOUR",
+		1: "GRANULARITY_DAY",
+	}
+	Granularity_value = map[string]int32{
+		"GRANULARITY_HOUR": 0,
+		"GRANULARITY_DAY":  1,
+	}
+)
+
+func (x Granularity) Enum() *Granularity {
+	p := new(Granularity)
+	*p = x
+	return p
+}
+
+func (x Granularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Granularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_circuitbreaker_proto_enumTypes[7].Descriptor()
+}
+
+func (Granularity) Type() protoreflect.EnumType {
+	return &file_circuitbreaker_proto_enumTypes[7]
+}
+
+func (x Granularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Granularity.Descriptor instead.
+func (Granularity) EnumDescriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{7}
+}
+
+type DecisionType int32
+
+const (
+	// The htlc is forwarded.
+	DecisionType_DECISION_TYPE_FORWARD DecisionType = 0
+	// The htlc is held in the queue of the incoming peer.
+	DecisionType_DECISION_TYPE_QUEUE DecisionType = 1
+	// The htlc is failed because a limit is exceeded.
+	DecisionType_DECISION_TYPE_REJECT DecisionType = 2
+	// The htlc is failed because the incoming peer is blocked.
+	DecisionType_DECISION_TYPE_BLOCK DecisionType = 3
+	// A forwarded htlc is resolved.
+	DecisionType_DECISION_TYPE_RESOLVE DecisionType = 4
+)
+
+// Enum value maps for DecisionType.
+var (
+	DecisionType_name = map[int32]string{
+		0: "DECISION_TYPE_FORWARD",
+		1: "DECISION_TYPE_QUEUE",
+		2: "DECISION_TYPE_REJECT",
+		3: "DECISION_TYPE_BLOCK",
+		4: "DECISION_TYPE_RESOLVE",
+	}
+	DecisionType_value = map[string]int32{
+		"DECISION_TYPE_FORWARD": 0,
+		"DECISION_TYPE_QUEUE":   1,
+		"DECISION_TYPE_REJECT":  2,
+		"DECISION_TYPE_BLOCK":   3,
+		"DECISION_TYPE_RESOLVE": 4,
+	}
+)
+
+func (x DecisionType) Enum() *DecisionType {
+	p := new(DecisionType)
+	*p = x
+	return p
+}
+
+func (x DecisionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DecisionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_circuitbreaker_proto_enumTypes[8].Descriptor()
+}
+
+func (DecisionType) Type() protoreflect.EnumType {
+	return &file_circuitbreaker_proto_enumTypes[8]
+}
+
+func (x DecisionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DecisionType.Descriptor instead.
+func (DecisionType) EnumDescriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{8}
+}
+
+type RejectReason int32
+
+const (
+	RejectReason_REJECT_REASON_NONE          RejectReason = 0
+	RejectReason_REJECT_REASON_PENDING_LIMIT RejectReason = 1
+	RejectReason_REJECT_REASON_RATE_LIMIT    RejectReason = 2
+	// The htlc would jump the queue of the incoming peer.
+	RejectReason_REJECT_REASON_QUEUE_NOT_EMPTY RejectReason = 3
+	// The queued htlc was failed by an operator.
+	RejectReason_REJECT_REASON_OPERATOR RejectReason = 4
+)
+
+// Enum value maps for RejectReason.
+var (
+	RejectReason_name = map[int32]string{
+		0: "REJECT_REASON_NONE",
+		1: "REJECT_REASON_PENDING_LIMIT",
+		2: "REJECT_REASON_RATE_LIMIT",
+		3: "REJECT_REASON_QUEUE_NOT_EMPTY",
+		4: "REJECT_REASON_OPERATOR",
+	}
+	RejectReason_value = map[string]int32{
+		"REJECT_REASON_NONE":            0,
+		"REJECT_REASON_PENDING_LIMIT":   1,
+		"REJECT_REASON_RATE_LIMIT":      2,
+		"REJECT_REASON_QUEUE_NOT_EMPTY": 3,
+		"REJECT_REASON_OPERATOR":        4,
+	}
+)
+
+func (x RejectReason) Enum() *RejectReason {
+	p := new(RejectReason)
+	*p = x
+	return p
+}
+
+func (x RejectReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RejectReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_circuitbreaker_proto_enumTypes[9].Descriptor()
+}
+
+func (RejectReason) Type() protoreflect.EnumType {
+	return &file_circuitbreaker_proto_enumTypes[9]
+}
+
+func (x RejectReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RejectReason.Descriptor instead.
+func (RejectReason) EnumDescriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{9}
+}
+
 type GetInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The lnd node to act on. Can be left empty if only a single node is
+	// connected.
+	NodeKey string `protobuf:"bytes,1,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
 }
 
 func (x *GetInfoRequest) Reset() {
@@ -103,42 +562,2576 @@ func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetInfoRequest) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetInfoRequest) GetNodeKey() string {
+	if x != nil {
+		return x.NodeKey
+	}
+	return ""
+}
+
+type GetInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeKey     string `protobuf:"bytes,1,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+	NodeAlias   string `protobuf:"bytes,2,opt,name=node_alias,json=nodeAlias,proto3" json:"node_alias,omitempty"`
+	NodeVersion string `protobuf:"bytes,3,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Version     string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// Whether the connected lnd version auto-fails htlcs that are held for too
+	// long. Without this protection, queue modes risk force-closes.
+	HtlcAutoFailSupported bool `protobuf:"varint,5,opt,name=htlc_auto_fail_supported,json=htlcAutoFailSupported,proto3" json:"htlc_auto_fail_supported,omitempty"`
+	// Whether queue modes can be configured. This is the case if lnd
+	// auto-fails held htlcs, or if circuitbreaker was started with
+	// --allowunsafequeue.
+	QueueModesAllowed bool `protobuf:"varint,6,opt,name=queue_modes_allowed,json=queueModesAllowed,proto3" json:"queue_modes_allowed,omitempty"`
+	// Whether lnd is configured to hold htlcs while circuitbreaker is not
+	// connected (requireinterceptor=true). If not, lnd forwards htlcs
+	// without enforcing limits while circuitbreaker is down.
+	InterceptorRequired bool `protobuf:"varint,7,opt,name=interceptor_required,json=interceptorRequired,proto3" json:"interceptor_required,omitempty"`
+}
+
+func (x *GetInfoResponse) Reset() {
+	*x = GetInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoResponse) ProtoMessage() {}
+
+func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetInfoResponse) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetInfoResponse) GetNodeKey() string {
+	if x != nil {
+		return x.NodeKey
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetNodeAlias() string {
+	if x != nil {
+		return x.NodeAlias
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetHtlcAutoFailSupported() bool {
+	if x != nil {
+		return x.HtlcAutoFailSupported
+	}
+	return false
+}
+
+func (x *GetInfoResponse) GetQueueModesAllowed() bool {
+	if x != nil {
+		return x.QueueModesAllowed
+	}
+	return false
+}
+
+func (x *GetInfoResponse) GetInterceptorRequired() bool {
+	if x != nil {
+		return x.InterceptorRequired
+	}
+	return false
+}
+
+type GetOverviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetOverviewRequest) Reset() {
+	*x = GetOverviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOverviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOverviewRequest) ProtoMessage() {}
+
+func (x *GetOverviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOverviewRequest.ProtoReflect.Descriptor instead.
+func (*GetOverviewRequest) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{2}
+}
+
+type GetOverviewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes []*NodeOverview `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// Totals across all connected nodes.
+	Counter_1H       *Counter `protobuf:"bytes,2,opt,name=counter_1h,json=counter1h,proto3" json:"counter_1h,omitempty"`
+	Counter_24H      *Counter `protobuf:"bytes,3,opt,name=counter_24h,json=counter24h,proto3" json:"counter_24h,omitempty"`
+	QueueLen         int64    `protobuf:"varint,4,opt,name=queue_len,json=queueLen,proto3" json:"queue_len,omitempty"`
+	PendingHtlcCount int64    `protobuf:"varint,5,opt,name=pending_htlc_count,json=pendingHtlcCount,proto3" json:"pending_htlc_count,omitempty"`
+}
+
+func (x *GetOverviewResponse) Reset() {
+	*x = GetOverviewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOverviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOverviewResponse) ProtoMessage() {}
+
+func (x *GetOverviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOverviewResponse.ProtoReflect.Descriptor instead.
+func (*GetOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetOverviewResponse) GetNodes() []*NodeOverview {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetOverviewResponse) GetCounter_1H() *Counter {
+	if x != nil {
+		return x.Counter_1H
+	}
+	return nil
+}
+
+func (x *GetOverviewResponse) GetCounter_24H() *Counter {
+	if x != nil {
+		return x.Counter_24H
+	}
+	return nil
+}
+
+func (x *GetOverviewResponse) GetQueueLen() int64 {
+	if x != nil {
+		return x.QueueLen
+	}
+	return 0
+}
+
+func (x *GetOverviewResponse) GetPendingHtlcCount() int64 {
+	if x != nil {
+		return x.PendingHtlcCount
+	}
+	return 0
+}
+
+type NodeOverview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeKey     string `protobuf:"bytes,1,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+	NodeAlias   string `protobuf:"bytes,2,opt,name=node_alias,json=nodeAlias,proto3" json:"node_alias,omitempty"`
+	NodeVersion string `protobuf:"bytes,3,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	// The number of peers for which htlcs are tracked.
+	PeerCount        int64    `protobuf:"varint,4,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
+	Counter_1H       *Counter `protobuf:"bytes,5,opt,name=counter_1h,json=counter1h,proto3" json:"counter_1h,omitempty"`
+	Counter_24H      *Counter `protobuf:"bytes,6,opt,name=counter_24h,json=counter24h,proto3" json:"counter_24h,omitempty"`
+	QueueLen         int64    `protobuf:"varint,7,opt,name=queue_len,json=queueLen,proto3" json:"queue_len,omitempty"`
+	PendingHtlcCount int64    `protobuf:"varint,8,opt,name=pending_htlc_count,json=pendingHtlcCount,proto3" json:"pending_htlc_count,omitempty"`
+}
+
+func (x *NodeOverview) Reset() {
+	*x = NodeOverview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeOverview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeOverview) ProtoMessage() {}
+
+func (x *NodeOverview) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeOverview.ProtoReflect.Descriptor instead.
+func (*NodeOverview) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NodeOverview) GetNodeKey() string {
+	if x != nil {
+		return x.NodeKey
+	}
+	return ""
+}
+
+func (x *NodeOverview) GetNodeAlias() string {
+	if x != nil {
+		return x.NodeAlias
+	}
+	return ""
+}
+
+func (x *NodeOverview) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *NodeOverview) GetPeerCount() int64 {
+	if x != nil {
+		return x.PeerCount
+	}
+	return 0
+}
+
+func (x *NodeOverview) GetCounter_1H() *Counter {
+	if x != nil {
+		return x.Counter_1H
+	}
+	return nil
+}
+
+func (x *NodeOverview) GetCounter_24H() *Counter {
+	if x != nil {
+		return x.Counter_24H
+	}
+	return nil
+}
+
+func (x *NodeOverview) GetQueueLen() int64 {
+	if x != nil {
+		return x.QueueLen
+	}
+	return 0
+}
+
+func (x *NodeOverview) GetPendingHtlcCount() int64 {
+	if x != nil {
+		return x.PendingHtlcCount
+	}
+	return 0
+}
+
+type ClearLimitsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes []string `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// The lnd node to act on. Can be left empty if only a single node is
+	// connected.
+	NodeKey string `protobuf:"bytes,2,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+}
+
+func (x *ClearLimitsRequest) Reset() {
+	*x = ClearLimitsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearLimitsRequest) ProtoMessage() {}
+
+func (x *ClearLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearLimitsRequest.ProtoReflect.Descriptor instead.
+func (*ClearLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ClearLimitsRequest) GetNodes() []string {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *ClearLimitsRequest) GetNodeKey() string {
+	if x != nil {
+		return x.NodeKey
+	}
+	return ""
+}
+
+type ClearLimitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClearLimitsResponse) Reset() {
+	*x = ClearLimitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearLimitsResponse) ProtoMessage() {}
+
+func (x *ClearLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearLimitsResponse.ProtoReflect.Descriptor instead.
+func (*ClearLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{6}
+}
+
+type UpdateLimitsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limits map[string]*Limit `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The lnd node to act on. Can be left empty if only a single node is
+	// connected.
+	NodeKey string `protobuf:"bytes,2,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+}
+
+func (x *UpdateLimitsRequest) Reset() {
+	*x = UpdateLimitsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLimitsRequest) ProtoMessage() {}
+
+func (x *UpdateLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLimitsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateLimitsRequest) GetLimits() map[string]*Limit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *UpdateLimitsRequest) GetNodeKey() string {
+	if x != nil {
+		return x.NodeKey
+	}
+	return ""
+}
+
+type UpdateLimitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateLimitsResponse) Reset() {
+	*x = UpdateLimitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLimitsResponse) ProtoMessage() {}
+
+func (x *UpdateLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLimitsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{8}
+}
+
+type UpdateDefaultLimitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit *Limit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// The lnd node to act on. Can be left empty if only a single node is
+	// connected.
+	NodeKey string `protobuf:"bytes,2,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+}
+
+func (x *UpdateDefaultLimitRequest) Reset() {
+	*x = UpdateDefaultLimitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDefaultLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDefaultLimitRequest) ProtoMessage() {}
+
+func (x *UpdateDefaultLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDefaultLimitRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDefaultLimitRequest) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateDefaultLimitRequest) GetLimit() *Limit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *UpdateDefaultLimitRequest) GetNodeKey() string {
+	if x != nil {
+		return x.NodeKey
+	}
+	return ""
+}
+
+type UpdateDefaultLimitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateDefaultLimitResponse) Reset() {
+	*x = UpdateDefaultLimitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDefaultLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDefaultLimitResponse) ProtoMessage() {}
+
+func (x *UpdateDefaultLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDefaultLimitResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDefaultLimitResponse) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{10}
+}
+
+type ListLimitsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The lnd node to act on. Can be left empty if only a single node is
+	// connected.
+	NodeKey string `protobuf:"bytes,1,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+}
+
+func (x *ListLimitsRequest) Reset() {
+	*x = ListLimitsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLimitsRequest) ProtoMessage() {}
+
+func (x *ListLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLimitsRequest.ProtoReflect.Descriptor instead.
+func (*ListLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListLimitsRequest) GetNodeKey() string {
+	if x != nil {
+		return x.NodeKey
+	}
+	return ""
+}
+
+type ListLimitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limits       []*NodeLimit `protobuf:"bytes,5,rep,name=limits,proto3" json:"limits,omitempty"`
+	DefaultLimit *Limit       `protobuf:"bytes,2,opt,name=default_limit,json=defaultLimit,proto3" json:"default_limit,omitempty"`
+}
+
+func (x *ListLimitsResponse) Reset() {
+	*x = ListLimitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLimitsResponse) ProtoMessage() {}
+
+func (x *ListLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLimitsResponse.ProtoReflect.Descriptor instead.
+func (*ListLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListLimitsResponse) GetLimits() []*NodeLimit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *ListLimitsResponse) GetDefaultLimit() *Limit {
+	if x != nil {
+		return x.DefaultLimit
+	}
+	return nil
+}
+
+type NodeLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node  string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Alias string `protobuf:"bytes,5,opt,name=alias,proto3" json:"alias,omitempty"`
+	// The default limit applies if limit is not set.
+	Limit            *Limit   `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Counter_1H       *Counter `protobuf:"bytes,3,opt,name=counter_1h,json=counter1h,proto3" json:"counter_1h,omitempty"`
+	Counter_24H      *Counter `protobuf:"bytes,4,opt,name=counter_24h,json=counter24h,proto3" json:"counter_24h,omitempty"`
+	QueueLen         int64    `protobuf:"varint,6,opt,name=queue_len,json=queueLen,proto3" json:"queue_len,omitempty"`
+	PendingHtlcCount int64    `protobuf:"varint,7,opt,name=pending_htlc_count,json=pendingHtlcCount,proto3" json:"pending_htlc_count,omitempty"`
+}
+
+func (x *NodeLimit) Reset() {
+	*x = NodeLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeLimit) ProtoMessage() {}
+
+func (x *NodeLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeLimit.ProtoReflect.Descriptor instead.
+func (*NodeLimit) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NodeLimit) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *NodeLimit) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *NodeLimit) GetLimit() *Limit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *NodeLimit) GetCounter_1H() *Counter {
+	if x != nil {
+		return x.Counter_1H
+	}
+	return nil
+}
+
+func (x *NodeLimit) GetCounter_24H() *Counter {
+	if x != nil {
+		return x.Counter_24H
+	}
+	return nil
+}
+
+func (x *NodeLimit) GetQueueLen() int64 {
+	if x != nil {
+		return x.QueueLen
+	}
+	return 0
+}
+
+func (x *NodeLimit) GetPendingHtlcCount() int64 {
+	if x != nil {
+		return x.PendingHtlcCount
+	}
+	return 0
+}
+
+type GetPeerDetailRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The lnd node to act on. Can be left empty if only a single node is
+	// connected.
+	NodeKey string `protobuf:"bytes,1,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+	// The peer to return the details of.
+	Node string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	// The windows over which statistics are computed, in seconds. Each window
+	// ends now. Defaults to one hour, one day and one week. Windows can be at
+	// most 30 days.
+	WindowSecs []uint64 `protobuf:"varint,3,rep,packed,name=window_secs,json=windowSecs,proto3" json:"window_secs,omitempty"`
+}
+
+func (x *GetPeerDetailRequest) Reset() {
+	*x = GetPeerDetailRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeerDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerDetailRequest) ProtoMessage() {}
+
+func (x *GetPeerDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerDetailRequest.ProtoReflect.Descriptor instead.
+func (*GetPeerDetailRequest) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetPeerDetailRequest) GetNodeKey() string {
+	if x != nil {
+		return x.NodeKey
+	}
+	return ""
+}
+
+func (x *GetPeerDetailRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *GetPeerDetailRequest) GetWindowSecs() []uint64 {
+	if x != nil {
+		return x.WindowSecs
+	}
+	return nil
+}
+
+type PeerChannel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShortChannelId uint64 `protobuf:"varint,1,opt,name=short_channel_id,json=shortChannelId,proto3" json:"short_channel_id,omitempty"`
+	// Whether the channel was opened by our node.
+	Initiator bool         `protobuf:"varint,2,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	State     ChannelState `protobuf:"varint,3,opt,name=state,proto3,enum=circuitbreaker.ChannelState" json:"state,omitempty"`
+}
+
+func (x *PeerChannel) Reset() {
+	*x = PeerChannel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerChannel) ProtoMessage() {}
+
+func (x *PeerChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerChannel.ProtoReflect.Descriptor instead.
+func (*PeerChannel) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PeerChannel) GetShortChannelId() uint64 {
+	if x != nil {
+		return x.ShortChannelId
+	}
+	return 0
+}
+
+func (x *PeerChannel) GetInitiator() bool {
+	if x != nil {
+		return x.Initiator
+	}
+	return false
+}
+
+func (x *PeerChannel) GetState() ChannelState {
+	if x != nil {
+		return x.State
+	}
+	return ChannelState_CHANNEL_STATE_ACTIVE
+}
+
+type PeerHtlc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncomingCircuit *CircuitKey `protobuf:"bytes,1,opt,name=incoming_circuit,json=incomingCircuit,proto3" json:"incoming_circuit,omitempty"`
+	// The channel that the htlc is requested to be forwarded over. Only set
+	// for queued htlcs.
+	OutgoingChannel uint64 `protobuf:"varint,2,opt,name=outgoing_channel,json=outgoingChannel,proto3" json:"outgoing_channel,omitempty"`
+	IncomingAmount  uint64 `protobuf:"varint,3,opt,name=incoming_amount,json=incomingAmount,proto3" json:"incoming_amount,omitempty"`
+	OutgoingAmount  uint64 `protobuf:"varint,4,opt,name=outgoing_amount,json=outgoingAmount,proto3" json:"outgoing_amount,omitempty"`
+	// The time that the htlc was forwarded or queued. Zero for htlcs that
+	// were already pending when circuitbreaker started.
+	SinceNs int64 `protobuf:"varint,5,opt,name=since_ns,json=sinceNs,proto3" json:"since_ns,omitempty"`
+	// The time that the htlc has been pending or queued. Zero if unknown.
+	AgeNs uint64 `protobuf:"varint,6,opt,name=age_ns,json=ageNs,proto3" json:"age_ns,omitempty"`
+}
+
+func (x *PeerHtlc) Reset() {
+	*x = PeerHtlc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerHtlc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerHtlc) ProtoMessage() {}
+
+func (x *PeerHtlc) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerHtlc.ProtoReflect.Descriptor instead.
+func (*PeerHtlc) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PeerHtlc) GetIncomingCircuit() *CircuitKey {
+	if x != nil {
+		return x.IncomingCircuit
+	}
+	return nil
+}
+
+func (x *PeerHtlc) GetOutgoingChannel() uint64 {
+	if x != nil {
+		return x.OutgoingChannel
+	}
+	return 0
+}
+
+func (x *PeerHtlc) GetIncomingAmount() uint64 {
+	if x != nil {
+		return x.IncomingAmount
+	}
+	return 0
+}
+
+func (x *PeerHtlc) GetOutgoingAmount() uint64 {
+	if x != nil {
+		return x.OutgoingAmount
+	}
+	return 0
+}
+
+func (x *PeerHtlc) GetSinceNs() int64 {
+	if x != nil {
+		return x.SinceNs
+	}
+	return 0
+}
+
+func (x *PeerHtlc) GetAgeNs() uint64 {
+	if x != nil {
+		return x.AgeNs
+	}
+	return 0
+}
+
+type PeerDirectionStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SettledCount uint64 `protobuf:"varint,1,opt,name=settled_count,json=settledCount,proto3" json:"settled_count,omitempty"`
+	FailedCount  uint64 `protobuf:"varint,2,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	// The share of resolved htlcs that settled. Zero if there are no htlcs.
+	SettleRatio float64 `protobuf:"fixed64,3,opt,name=settle_ratio,json=settleRatio,proto3" json:"settle_ratio,omitempty"`
+	// The fee earned with the settled htlcs.
+	FeesMsat uint64 `protobuf:"varint,4,opt,name=fees_msat,json=feesMsat,proto3" json:"fees_msat,omitempty"`
+	// Percentiles of the time that htlcs were in flight. Htlcs with an
+	// unknown add time are not included.
+	HoldTimeP50Ns uint64 `protobuf:"varint,5,opt,name=hold_time_p50_ns,json=holdTimeP50Ns,proto3" json:"hold_time_p50_ns,omitempty"`
+	HoldTimeP90Ns uint64 `protobuf:"varint,6,opt,name=hold_time_p90_ns,json=holdTimeP90Ns,proto3" json:"hold_time_p90_ns,omitempty"`
+	HoldTimeP99Ns uint64 `protobuf:"varint,7,opt,name=hold_time_p99_ns,json=holdTimeP99Ns,proto3" json:"hold_time_p99_ns,omitempty"`
+}
+
+func (x *PeerDirectionStats) Reset() {
+	*x = PeerDirectionStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerDirectionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerDirectionStats) ProtoMessage() {}
+
+func (x *PeerDirectionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerDirectionStats.ProtoReflect.Descriptor instead.
+func (*PeerDirectionStats) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PeerDirectionStats) GetSettledCount() uint64 {
+	if x != nil {
+		return x.SettledCount
+	}
+	return 0
+}
+
+func (x *PeerDirectionStats) GetFailedCount() uint64 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *PeerDirectionStats) GetSettleRatio() float64 {
+	if x != nil {
+		return x.SettleRatio
+	}
+	return 0
+}
+
+func (x *PeerDirectionStats) GetFeesMsat() uint64 {
+	if x != nil {
+		return x.FeesMsat
+	}
+	return 0
+}
+
+func (x *PeerDirectionStats) GetHoldTimeP50Ns() uint64 {
+	if x != nil {
+		return x.HoldTimeP50Ns
+	}
+	return 0
+}
+
+func (x *PeerDirectionStats) GetHoldTimeP90Ns() uint64 {
+	if x != nil {
+		return x.HoldTimeP90Ns
+	}
+	return 0
+}
+
+func (x *PeerDirectionStats) GetHoldTimeP99Ns() uint64 {
+	if x != nil {
+		return x.HoldTimeP99Ns
+	}
+	return 0
+}
+
+type PeerStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WindowSecs uint64 `protobuf:"varint,1,opt,name=window_secs,json=windowSecs,proto3" json:"window_secs,omitempty"`
+	// Statistics of the htlcs that the peer forwarded to us.
+	Incoming *PeerDirectionStats `protobuf:"bytes,2,opt,name=incoming,proto3" json:"incoming,omitempty"`
+	// Statistics of the htlcs that we forwarded to the peer.
+	Outgoing *PeerDirectionStats `protobuf:"bytes,3,opt,name=outgoing,proto3" json:"outgoing,omitempty"`
+}
+
+func (x *PeerStats) Reset() {
+	*x = PeerStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerStats) ProtoMessage() {}
+
+func (x *PeerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerStats.ProtoReflect.Descriptor instead.
+func (*PeerStats) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PeerStats) GetWindowSecs() uint64 {
+	if x != nil {
+		return x.WindowSecs
+	}
+	return 0
+}
+
+func (x *PeerStats) GetIncoming() *PeerDirectionStats {
+	if x != nil {
+		return x.Incoming
+	}
+	return nil
+}
+
+func (x *PeerStats) GetOutgoing() *PeerDirectionStats {
+	if x != nil {
+		return x.Outgoing
+	}
+	return nil
+}
+
+type GetPeerDetailResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node  string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Alias string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	// The open and closed channels with the peer.
+	Channels []*PeerChannel `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels,omitempty"`
+	// The limit that is currently enforced. Limits are configured per peer,
+	// not per channel.
+	Limit       *Limit      `protobuf:"bytes,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	LimitSource LimitSource `protobuf:"varint,5,opt,name=limit_source,json=limitSource,proto3,enum=circuitbreaker.LimitSource" json:"limit_source,omitempty"`
+	// The number of htlcs that the rate limiter currently allows to be
+	// forwarded without waiting. Negative if htlcs are waiting for a token.
+	LimiterTokens float64  `protobuf:"fixed64,6,opt,name=limiter_tokens,json=limiterTokens,proto3" json:"limiter_tokens,omitempty"`
+	Counter_1H    *Counter `protobuf:"bytes,7,opt,name=counter_1h,json=counter1h,proto3" json:"counter_1h,omitempty"`
+	Counter_24H   *Counter `protobuf:"bytes,8,opt,name=counter_24h,json=counter24h,proto3" json:"counter_24h,omitempty"`
+	// The htlcs that were forwarded and are not yet resolved, oldest first.
+	PendingHtlcs []*PeerHtlc `protobuf:"bytes,9,rep,name=pending_htlcs,json=pendingHtlcs,proto3" json:"pending_htlcs,omitempty"`
+	// The htlcs in the queue, starting with the next htlc to be forwarded.
+	QueuedHtlcs []*PeerHtlc `protobuf:"bytes,10,rep,name=queued_htlcs,json=queuedHtlcs,proto3" json:"queued_htlcs,omitempty"`
+	// Forwarding statistics for each of the requested windows.
+	Stats []*PeerStats `protobuf:"bytes,11,rep,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *GetPeerDetailResponse) Reset() {
+	*x = GetPeerDetailResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeerDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerDetailResponse) ProtoMessage() {}
+
+func (x *GetPeerDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerDetailResponse.ProtoReflect.Descriptor instead.
+func (*GetPeerDetailResponse) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetPeerDetailResponse) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *GetPeerDetailResponse) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *GetPeerDetailResponse) GetChannels() []*PeerChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *GetPeerDetailResponse) GetLimit() *Limit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *GetPeerDetailResponse) GetLimitSource() LimitSource {
+	if x != nil {
+		return x.LimitSource
+	}
+	return LimitSource_LIMIT_SOURCE_DEFAULT
+}
+
+func (x *GetPeerDetailResponse) GetLimiterTokens() float64 {
+	if x != nil {
+		return x.LimiterTokens
+	}
+	return 0
+}
+
+func (x *GetPeerDetailResponse) GetCounter_1H() *Counter {
+	if x != nil {
+		return x.Counter_1H
+	}
+	return nil
+}
+
+func (x *GetPeerDetailResponse) GetCounter_24H() *Counter {
+	if x != nil {
+		return x.Counter_24H
+	}
+	return nil
+}
+
+func (x *GetPeerDetailResponse) GetPendingHtlcs() []*PeerHtlc {
+	if x != nil {
+		return x.PendingHtlcs
+	}
+	return nil
+}
+
+func (x *GetPeerDetailResponse) GetQueuedHtlcs() []*PeerHtlc {
+	if x != nil {
+		return x.QueuedHtlcs
+	}
+	return nil
+}
+
+func (x *GetPeerDetailResponse) GetStats() []*PeerStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type ListQueuedHtlcsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The lnd node to act on. Can be left empty if only a single node is
+	// connected.
+	NodeKey string `protobuf:"bytes,1,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+	// Only return the queue of this peer.
+	Node string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *ListQueuedHtlcsRequest) Reset() {
+	*x = ListQueuedHtlcsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListQueuedHtlcsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuedHtlcsRequest) ProtoMessage() {}
+
+func (x *ListQueuedHtlcsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuedHtlcsRequest.ProtoReflect.Descriptor instead.
+func (*ListQueuedHtlcsRequest) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListQueuedHtlcsRequest) GetNodeKey() string {
+	if x != nil {
+		return x.NodeKey
+	}
+	return ""
+}
+
+func (x *ListQueuedHtlcsRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type PeerQueue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node  string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Alias string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	// The queued htlcs, starting with the next htlc to be forwarded.
+	Htlcs []*PeerHtlc `protobuf:"bytes,3,rep,name=htlcs,proto3" json:"htlcs,omitempty"`
+}
+
+func (x *PeerQueue) Reset() {
+	*x = PeerQueue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerQueue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerQueue) ProtoMessage() {}
+
+func (x *PeerQueue) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerQueue.ProtoReflect.Descriptor instead.
+func (*PeerQueue) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PeerQueue) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *PeerQueue) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *PeerQueue) GetHtlcs() []*PeerHtlc {
+	if x != nil {
+		return x.Htlcs
+	}
+	return nil
+}
+
+type ListQueuedHtlcsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The queues that are not empty.
+	Queues []*PeerQueue `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+}
+
+func (x *ListQueuedHtlcsResponse) Reset() {
+	*x = ListQueuedHtlcsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListQueuedHtlcsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuedHtlcsResponse) ProtoMessage() {}
+
+func (x *ListQueuedHtlcsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuedHtlcsResponse.ProtoReflect.Descriptor instead.
+func (*ListQueuedHtlcsResponse) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListQueuedHtlcsResponse) GetQueues() []*PeerQueue {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+type ResolveQueuedHtlcRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The lnd node to act on. Can be left empty if only a single node is
+	// connected.
+	NodeKey string `protobuf:"bytes,1,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+	// The incoming circuit of the queued htlc.
+	IncomingCircuit *CircuitKey `protobuf:"bytes,2,opt,name=incoming_circuit,json=incomingCircuit,proto3" json:"incoming_circuit,omitempty"`
+	Action          QueueAction `protobuf:"varint,3,opt,name=action,proto3,enum=circuitbreaker.QueueAction" json:"action,omitempty"`
+}
+
+func (x *ResolveQueuedHtlcRequest) Reset() {
+	*x = ResolveQueuedHtlcRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveQueuedHtlcRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveQueuedHtlcRequest) ProtoMessage() {}
+
+func (x *ResolveQueuedHtlcRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveQueuedHtlcRequest.ProtoReflect.Descriptor instead.
+func (*ResolveQueuedHtlcRequest) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ResolveQueuedHtlcRequest) GetNodeKey() string {
+	if x != nil {
+		return x.NodeKey
+	}
+	return ""
+}
+
+func (x *ResolveQueuedHtlcRequest) GetIncomingCircuit() *CircuitKey {
+	if x != nil {
+		return x.IncomingCircuit
+	}
+	return nil
+}
+
+func (x *ResolveQueuedHtlcRequest) GetAction() QueueAction {
+	if x != nil {
+		return x.Action
+	}
+	return QueueAction_QUEUE_ACTION_UNKNOWN
+}
+
+type ResolveQueuedHtlcResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResolveQueuedHtlcResponse) Reset() {
+	*x = ResolveQueuedHtlcResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveQueuedHtlcResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveQueuedHtlcResponse) ProtoMessage() {}
+
+func (x *ResolveQueuedHtlcResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveQueuedHtlcResponse.ProtoReflect.Descriptor instead.
+func (*ResolveQueuedHtlcResponse) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{24}
+}
+
+type FlushQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The lnd node to act on. Can be left empty if only a single node is
+	// connected.
+	NodeKey string `protobuf:"bytes,1,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+	// The peer of which to flush the queue.
+	Node   string      `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Action QueueAction `protobuf:"varint,3,opt,name=action,proto3,enum=circuitbreaker.QueueAction" json:"action,omitempty"`
+}
+
+func (x *FlushQueueRequest) Reset() {
+	*x = FlushQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlushQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushQueueRequest) ProtoMessage() {}
+
+func (x *FlushQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushQueueRequest.ProtoReflect.Descriptor instead.
+func (*FlushQueueRequest) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FlushQueueRequest) GetNodeKey() string {
+	if x != nil {
+		return x.NodeKey
+	}
+	return ""
+}
+
+func (x *FlushQueueRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *FlushQueueRequest) GetAction() QueueAction {
+	if x != nil {
+		return x.Action
+	}
+	return QueueAction_QUEUE_ACTION_UNKNOWN
+}
+
+type FlushQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of htlcs that were forwarded or failed.
+	Resolved uint64 `protobuf:"varint,1,opt,name=resolved,proto3" json:"resolved,omitempty"`
+}
+
+func (x *FlushQueueResponse) Reset() {
+	*x = FlushQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlushQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushQueueResponse) ProtoMessage() {}
+
+func (x *FlushQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushQueueResponse.ProtoReflect.Descriptor instead.
+func (*FlushQueueResponse) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FlushQueueResponse) GetResolved() uint64 {
+	if x != nil {
+		return x.Resolved
+	}
+	return 0
+}
+
+type ListQueueActionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The lnd node to act on. Can be left empty if only a single node is
+	// connected.
+	NodeKey string `protobuf:"bytes,1,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+	// Only return the actions on htlcs of this peer.
+	Node string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	// The maximum number of actions to return. Zero means no maximum.
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListQueueActionsRequest) Reset() {
+	*x = ListQueueActionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListQueueActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueueActionsRequest) ProtoMessage() {}
+
+func (x *ListQueueActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueueActionsRequest.ProtoReflect.Descriptor instead.
+func (*ListQueueActionsRequest) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListQueueActionsRequest) GetNodeKey() string {
+	if x != nil {
+		return x.NodeKey
+	}
+	return ""
+}
+
+func (x *ListQueueActionsRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ListQueueActionsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type QueueActionRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TimestampNs int64       `protobuf:"varint,2,opt,name=timestamp_ns,json=timestampNs,proto3" json:"timestamp_ns,omitempty"`
+	Node        string      `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	Action      QueueAction `protobuf:"varint,4,opt,name=action,proto3,enum=circuitbreaker.QueueAction" json:"action,omitempty"`
+	// The htlc that the action was taken on.
+	Htlc *PeerHtlc `protobuf:"bytes,5,opt,name=htlc,proto3" json:"htlc,omitempty"`
+	// Identifies the api caller that took the action.
+	Caller string `protobuf:"bytes,6,opt,name=caller,proto3" json:"caller,omitempty"`
+}
+
+func (x *QueueActionRecord) Reset() {
+	*x = QueueActionRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueActionRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueActionRecord) ProtoMessage() {}
+
+func (x *QueueActionRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueActionRecord.ProtoReflect.Descriptor instead.
+func (*QueueActionRecord) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *QueueActionRecord) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QueueActionRecord) GetTimestampNs() int64 {
+	if x != nil {
+		return x.TimestampNs
+	}
+	return 0
+}
+
+func (x *QueueActionRecord) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *QueueActionRecord) GetAction() QueueAction {
+	if x != nil {
+		return x.Action
+	}
+	return QueueAction_QUEUE_ACTION_UNKNOWN
+}
+
+func (x *QueueActionRecord) GetHtlc() *PeerHtlc {
+	if x != nil {
+		return x.Htlc
+	}
+	return nil
+}
+
+func (x *QueueActionRecord) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+type ListQueueActionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Actions []*QueueActionRecord `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+}
+
+func (x *ListQueueActionsResponse) Reset() {
+	*x = ListQueueActionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListQueueActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueueActionsResponse) ProtoMessage() {}
+
+func (x *ListQueueActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueueActionsResponse.ProtoReflect.Descriptor instead.
+func (*ListQueueActionsResponse) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListQueueActionsResponse) GetActions() []*QueueActionRecord {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type ListLimitChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The lnd node to act on. Can be left empty if only a single node is
+	// connected.
+	NodeKey string `protobuf:"bytes,1,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+	// Only return the changes to the limit of this peer.
+	Node string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	// Only return the changes to the default limit.
+	DefaultLimit bool `protobuf:"varint,3,opt,name=default_limit,json=defaultLimit,proto3" json:"default_limit,omitempty"`
+	// The maximum number of changes to return. Zero means no maximum.
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListLimitChangesRequest) Reset() {
+	*x = ListLimitChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLimitChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLimitChangesRequest) ProtoMessage() {}
+
+func (x *ListLimitChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLimitChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListLimitChangesRequest) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListLimitChangesRequest) GetNodeKey() string {
+	if x != nil {
+		return x.NodeKey
+	}
+	return ""
+}
+
+func (x *ListLimitChangesRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ListLimitChangesRequest) GetDefaultLimit() bool {
+	if x != nil {
+		return x.DefaultLimit
+	}
+	return false
+}
+
+func (x *ListLimitChangesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListLimitChangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*LimitChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *ListLimitChangesResponse) Reset() {
+	*x = ListLimitChangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLimitChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLimitChangesResponse) ProtoMessage() {}
+
+func (x *ListLimitChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLimitChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListLimitChangesResponse) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListLimitChangesResponse) GetChanges() []*LimitChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type LimitChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TimestampNs int64 `protobuf:"varint,2,opt,name=timestamp_ns,json=timestampNs,proto3" json:"timestamp_ns,omitempty"`
+	// The peer of which the limit changed. Empty for changes to the default
+	// limit.
+	Node string `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	// The limits before and after the change. Not set if the peer didn't
+	// have a limit of its own.
+	OldLimit *Limit `protobuf:"bytes,4,opt,name=old_limit,json=oldLimit,proto3" json:"old_limit,omitempty"`
+	NewLimit *Limit `protobuf:"bytes,5,opt,name=new_limit,json=newLimit,proto3" json:"new_limit,omitempty"`
+	// Identifies the api caller that made the change.
+	Caller string `protobuf:"bytes,6,opt,name=caller,proto3" json:"caller,omitempty"`
+	// The id of the change that this change reverted. Zero if the change
+	// isn't a revert.
+	RevertedChangeId int64 `protobuf:"varint,7,opt,name=reverted_change_id,json=revertedChangeId,proto3" json:"reverted_change_id,omitempty"`
+}
+
+func (x *LimitChange) Reset() {
+	*x = LimitChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LimitChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitChange) ProtoMessage() {}
+
+func (x *LimitChange) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimitChange.ProtoReflect.Descriptor instead.
+func (*LimitChange) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LimitChange) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LimitChange) GetTimestampNs() int64 {
+	if x != nil {
+		return x.TimestampNs
+	}
+	return 0
+}
+
+func (x *LimitChange) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *LimitChange) GetOldLimit() *Limit {
+	if x != nil {
+		return x.OldLimit
+	}
+	return nil
+}
+
+func (x *LimitChange) GetNewLimit() *Limit {
+	if x != nil {
+		return x.NewLimit
+	}
+	return nil
+}
+
+func (x *LimitChange) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *LimitChange) GetRevertedChangeId() int64 {
+	if x != nil {
+		return x.RevertedChangeId
+	}
+	return 0
+}
+
+type RevertLimitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The lnd node to act on. Can be left empty if only a single node is
+	// connected.
+	NodeKey string `protobuf:"bytes,1,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+	// The peer of which to revert the limit. If empty, the default limit is
+	// reverted.
+	Node string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *RevertLimitRequest) Reset() {
+	*x = RevertLimitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertLimitRequest) ProtoMessage() {}
+
+func (x *RevertLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertLimitRequest.ProtoReflect.Descriptor instead.
+func (*RevertLimitRequest) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RevertLimitRequest) GetNodeKey() string {
+	if x != nil {
+		return x.NodeKey
+	}
+	return ""
+}
+
+func (x *RevertLimitRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type RevertLimitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the change that was reverted.
+	RevertedChangeId int64 `protobuf:"varint,1,opt,name=reverted_change_id,json=revertedChangeId,proto3" json:"reverted_change_id,omitempty"`
+}
+
+func (x *RevertLimitResponse) Reset() {
+	*x = RevertLimitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertLimitResponse) ProtoMessage() {}
+
+func (x *RevertLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertLimitResponse.ProtoReflect.Descriptor instead.
+func (*RevertLimitResponse) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RevertLimitResponse) GetRevertedChangeId() int64 {
+	if x != nil {
+		return x.RevertedChangeId
+	}
+	return 0
+}
+
+type Limit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxHourlyRate int64 `protobuf:"varint,3,opt,name=max_hourly_rate,json=maxHourlyRate,proto3" json:"max_hourly_rate,omitempty"`
+	MaxPending    int64 `protobuf:"varint,5,opt,name=max_pending,json=maxPending,proto3" json:"max_pending,omitempty"`
+	Mode          Mode  `protobuf:"varint,6,opt,name=mode,proto3,enum=circuitbreaker.Mode" json:"mode,omitempty"`
+}
+
+func (x *Limit) Reset() {
+	*x = Limit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Limit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Limit) ProtoMessage() {}
+
+func (x *Limit) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Limit.ProtoReflect.Descriptor instead.
+func (*Limit) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Limit) GetMaxHourlyRate() int64 {
+	if x != nil {
+		return x.MaxHourlyRate
+	}
+	return 0
+}
+
+func (x *Limit) GetMaxPending() int64 {
+	if x != nil {
+		return x.MaxPending
+	}
+	return 0
+}
+
+func (x *Limit) GetMode() Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Mode_MODE_FAIL
+}
+
+type Counter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fail    int64 `protobuf:"varint,1,opt,name=fail,proto3" json:"fail,omitempty"`
+	Success int64 `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Reject  int64 `protobuf:"varint,3,opt,name=reject,proto3" json:"reject,omitempty"`
+}
+
+func (x *Counter) Reset() {
+	*x = Counter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Counter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Counter) ProtoMessage() {}
+
+func (x *Counter) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Counter.ProtoReflect.Descriptor instead.
+func (*Counter) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Counter) GetFail() int64 {
+	if x != nil {
+		return x.Fail
+	}
+	return 0
+}
+
+func (x *Counter) GetSuccess() int64 {
+	if x != nil {
+		return x.Success
+	}
+	return 0
+}
+
+func (x *Counter) GetReject() int64 {
+	if x != nil {
+		return x.Reject
+	}
+	return 0
+}
+
+type ListForwardingHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The inclusive start time for the query, used to filter HTLCs by the time they were added
+	// to local incoming channel. If this value is zero, it will be treated as the unix epoch.
+	AddStartTimeNs int64 `protobuf:"varint,1,opt,name=add_start_time_ns,json=addStartTimeNs,proto3" json:"add_start_time_ns,omitempty"`
+	// The exclusive end time for the query, used to filter HTLCs by the time they were added to
+	// the local incoming channel. If this value is zero, it will be assumed to be the current time.
+	AddEndTimeNs int64 `protobuf:"varint,2,opt,name=add_end_time_ns,json=addEndTimeNs,proto3" json:"add_end_time_ns,omitempty"`
+	// The lnd node to act on. Can be left empty if only a single node is
+	// connected.
+	NodeKey string `protobuf:"bytes,3,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+	// The maximum number of forwards to return. If zero, 1000 forwards are
+	// returned. Limits above 10000 are reduced to 10000. Use next_cursor in
+	// the response to retrieve the remaining forwards.
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// The number of matching forwards to skip. Cannot be combined with after.
+	Offset uint64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Continue listing after the forward that this cursor points at. Set it
+	// to the next_cursor of the previous response.
+	After string `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+	// If set, only return forwards from this peer.
+	IncomingPeer string `protobuf:"bytes,7,opt,name=incoming_peer,json=incomingPeer,proto3" json:"incoming_peer,omitempty"`
+	// If set, only return forwards to this peer.
+	OutgoingPeer string `protobuf:"bytes,8,opt,name=outgoing_peer,json=outgoingPeer,proto3" json:"outgoing_peer,omitempty"`
+	// If set, only return forwards that came in or went out through this
+	// channel.
+	Channel uint64        `protobuf:"varint,9,opt,name=channel,proto3" json:"channel,omitempty"`
+	Settled SettledFilter `protobuf:"varint,10,opt,name=settled,proto3,enum=circuitbreaker.SettledFilter" json:"settled,omitempty"`
+	// Forwards are sorted by add time.
+	SortOrder SortOrder `protobuf:"varint,11,opt,name=sort_order,json=sortOrder,proto3,enum=circuitbreaker.SortOrder" json:"sort_order,omitempty"`
+}
+
+func (x *ListForwardingHistoryRequest) Reset() {
+	*x = ListForwardingHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circuitbreaker_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListForwardingHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListForwardingHistoryRequest) ProtoMessage() {}
+
+func (x *ListForwardingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListForwardingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListForwardingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListForwardingHistoryRequest) GetAddStartTimeNs() int64 {
+	if x != nil {
+		return x.AddStartTimeNs
+	}
+	return 0
+}
+
+func (x *ListForwardingHistoryRequest) GetAddEndTimeNs() int64 {
+	if x != nil {
+		return x.AddEndTimeNs
+	}
+	return 0
+}
+
+func (x *ListForwardingHistoryRequest) GetNodeKey() string {
+	if x != nil {
+		return x.NodeKey
+	}
+	return ""
+}
+
+func (x *ListForwardingHistoryRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListForwardingHistoryRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListForwardingHistoryRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *ListForwardingHistoryRequest) GetIncomingPeer() string {
+	if x != nil {
+		return x.IncomingPeer
+	}
+	return ""
+}
+
+func (x *ListForwardingHistoryRequest) GetOutgoingPeer() string {
+	if x != nil {
+		return x.OutgoingPeer
+	}
+	return ""
+}
+
+func (x *ListForwardingHistoryRequest) GetChannel() uint64 {
+	if x != nil {
+		return x.Channel
+	}
+	return 0
+}
+
+func (x *ListForwardingHistoryRequest) GetSettled() SettledFilter {
+	if x != nil {
+		return x.Settled
+	}
+	return SettledFilter_SETTLED_FILTER_ALL
 }
 
-// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return file_circuitbreaker_proto_rawDescGZIP(), []int{0}
+func (x *ListForwardingHistoryRequest) GetSortOrder() SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return SortOrder_SORT_ORDER_ASCENDING
 }
 
-type GetInfoResponse struct {
+type ListForwardingHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeKey     string `protobuf:"bytes,1,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
-	NodeAlias   string `protobuf:"bytes,2,opt,name=node_alias,json=nodeAlias,proto3" json:"node_alias,omitempty"`
-	NodeVersion string `protobuf:"bytes,3,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	Version     string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Forwards []*Forward `protobuf:"bytes,1,rep,name=forwards,proto3" json:"forwards,omitempty"`
+	// The cursor to pass in as after to retrieve the next page. Empty if
+	// there are no more forwards.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 }
 
-func (x *GetInfoResponse) Reset() {
-	*x = GetInfoResponse{}
+func (x *ListForwardingHistoryResponse) Reset() {
+	*x = ListForwardingHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circuitbreaker_proto_msgTypes[1]
+		mi := &file_circuitbreaker_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetInfoResponse) String() string {
+func (x *ListForwardingHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInfoResponse) ProtoMessage() {}
+func (*ListForwardingHistoryResponse) ProtoMessage() {}
 
-func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_circuitbreaker_proto_msgTypes[1]
+func (x *ListForwardingHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -149,64 +3142,51 @@ func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_circuitbreaker_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *GetInfoResponse) GetNodeKey() string {
-	if x != nil {
-		return x.NodeKey
-	}
-	return ""
-}
-
-func (x *GetInfoResponse) GetNodeAlias() string {
-	if x != nil {
-		return x.NodeAlias
-	}
-	return ""
+// Deprecated: Use ListForwardingHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListForwardingHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetInfoResponse) GetNodeVersion() string {
+func (x *ListForwardingHistoryResponse) GetForwards() []*Forward {
 	if x != nil {
-		return x.NodeVersion
+		return x.Forwards
 	}
-	return ""
+	return nil
 }
 
-func (x *GetInfoResponse) GetVersion() string {
+func (x *ListForwardingHistoryResponse) GetNextCursor() string {
 	if x != nil {
-		return x.Version
+		return x.NextCursor
 	}
 	return ""
 }
 
-type ClearLimitsRequest struct {
+type CircuitKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nodes []string `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	ShortChannelId uint64 `protobuf:"varint,1,opt,name=short_channel_id,json=shortChannelId,proto3" json:"short_channel_id,omitempty"`
+	HtlcIndex      uint32 `protobuf:"varint,2,opt,name=htlc_index,json=htlcIndex,proto3" json:"htlc_index,omitempty"`
 }
 
-func (x *ClearLimitsRequest) Reset() {
-	*x = ClearLimitsRequest{}
+func (x *CircuitKey) Reset() {
+	*x = CircuitKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circuitbreaker_proto_msgTypes[2]
+		mi := &file_circuitbreaker_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClearLimitsRequest) String() string {
+func (x *CircuitKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearLimitsRequest) ProtoMessage() {}
+func (*CircuitKey) ProtoMessage() {}
 
-func (x *ClearLimitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_circuitbreaker_proto_msgTypes[2]
+func (x *CircuitKey) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -217,41 +3197,65 @@ func (x *ClearLimitsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearLimitsRequest.ProtoReflect.Descriptor instead.
-func (*ClearLimitsRequest) Descriptor() ([]byte, []int) {
-	return file_circuitbreaker_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use CircuitKey.ProtoReflect.Descriptor instead.
+func (*CircuitKey) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ClearLimitsRequest) GetNodes() []string {
+func (x *CircuitKey) GetShortChannelId() uint64 {
 	if x != nil {
-		return x.Nodes
+		return x.ShortChannelId
 	}
-	return nil
+	return 0
 }
 
-type ClearLimitsResponse struct {
+func (x *CircuitKey) GetHtlcIndex() uint32 {
+	if x != nil {
+		return x.HtlcIndex
+	}
+	return 0
+}
+
+type Forward struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The time that the htlc was added to the incoming channel. Note that this value
+	// may be zero if circuitbreaker restarted while the htlc was in flight - this
+	// indicates that the value is unknown.
+	AddTimeNs       uint64      `protobuf:"varint,1,opt,name=add_time_ns,json=addTimeNs,proto3" json:"add_time_ns,omitempty"`
+	ResolveTimeNs   uint64      `protobuf:"varint,2,opt,name=resolve_time_ns,json=resolveTimeNs,proto3" json:"resolve_time_ns,omitempty"`
+	Settled         bool        `protobuf:"varint,3,opt,name=settled,proto3" json:"settled,omitempty"`
+	IncomingAmount  uint64      `protobuf:"varint,4,opt,name=incoming_amount,json=incomingAmount,proto3" json:"incoming_amount,omitempty"`
+	OutgoingAmount  uint64      `protobuf:"varint,5,opt,name=outgoing_amount,json=outgoingAmount,proto3" json:"outgoing_amount,omitempty"`
+	IncomingPeer    string      `protobuf:"bytes,6,opt,name=incoming_peer,json=incomingPeer,proto3" json:"incoming_peer,omitempty"`
+	IncomingCircuit *CircuitKey `protobuf:"bytes,7,opt,name=incoming_circuit,json=incomingCircuit,proto3" json:"incoming_circuit,omitempty"`
+	OutgoingPeer    string      `protobuf:"bytes,8,opt,name=outgoing_peer,json=outgoingPeer,proto3" json:"outgoing_peer,omitempty"`
+	OutgoingCircuit *CircuitKey `protobuf:"bytes,9,opt,name=outgoing_circuit,json=outgoingCircuit,proto3" json:"outgoing_circuit,omitempty"`
+	// Where the forward was recorded. Lnd doesn't log the add time and htlc
+	// indices, so for imported forwards the add time is set to the resolve
+	// time and the htlc indices are zero.
+	Source ForwardSource `protobuf:"varint,10,opt,name=source,proto3,enum=circuitbreaker.ForwardSource" json:"source,omitempty"`
 }
 
-func (x *ClearLimitsResponse) Reset() {
-	*x = ClearLimitsResponse{}
+func (x *Forward) Reset() {
+	*x = Forward{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circuitbreaker_proto_msgTypes[3]
+		mi := &file_circuitbreaker_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClearLimitsResponse) String() string {
+func (x *Forward) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearLimitsResponse) ProtoMessage() {}
+func (*Forward) ProtoMessage() {}
 
-func (x *ClearLimitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_circuitbreaker_proto_msgTypes[3]
+func (x *Forward) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -262,81 +3266,115 @@ func (x *ClearLimitsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearLimitsResponse.ProtoReflect.Descriptor instead.
-func (*ClearLimitsResponse) Descriptor() ([]byte, []int) {
-	return file_circuitbreaker_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Forward.ProtoReflect.Descriptor instead.
+func (*Forward) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{40}
 }
 
-type UpdateLimitsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Forward) GetAddTimeNs() uint64 {
+	if x != nil {
+		return x.AddTimeNs
+	}
+	return 0
+}
 
-	Limits map[string]*Limit `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (x *Forward) GetResolveTimeNs() uint64 {
+	if x != nil {
+		return x.ResolveTimeNs
+	}
+	return 0
 }
 
-func (x *UpdateLimitsRequest) Reset() {
-	*x = UpdateLimitsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_circuitbreaker_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Forward) GetSettled() bool {
+	if x != nil {
+		return x.Settled
 	}
+	return false
 }
 
-func (x *UpdateLimitsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Forward) GetIncomingAmount() uint64 {
+	if x != nil {
+		return x.IncomingAmount
+	}
+	return 0
 }
 
-func (*UpdateLimitsRequest) ProtoMessage() {}
+func (x *Forward) GetOutgoingAmount() uint64 {
+	if x != nil {
+		return x.OutgoingAmount
+	}
+	return 0
+}
 
-func (x *UpdateLimitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_circuitbreaker_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Forward) GetIncomingPeer() string {
+	if x != nil {
+		return x.IncomingPeer
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateLimitsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateLimitsRequest) Descriptor() ([]byte, []int) {
-	return file_circuitbreaker_proto_rawDescGZIP(), []int{4}
+func (x *Forward) GetIncomingCircuit() *CircuitKey {
+	if x != nil {
+		return x.IncomingCircuit
+	}
+	return nil
 }
 
-func (x *UpdateLimitsRequest) GetLimits() map[string]*Limit {
+func (x *Forward) GetOutgoingPeer() string {
 	if x != nil {
-		return x.Limits
+		return x.OutgoingPeer
+	}
+	return ""
+}
+
+func (x *Forward) GetOutgoingCircuit() *CircuitKey {
+	if x != nil {
+		return x.OutgoingCircuit
 	}
 	return nil
 }
 
-type UpdateLimitsResponse struct {
+func (x *Forward) GetSource() ForwardSource {
+	if x != nil {
+		return x.Source
+	}
+	return ForwardSource_FORWARD_SOURCE_LIVE
+}
+
+type ExportForwardingHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The lnd node to act on. Can be left empty if only a single node is
+	// connected.
+	NodeKey string `protobuf:"bytes,1,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+	// The inclusive start time of the export, used to filter HTLCs by the time
+	// they were added. If this value is zero, it will be treated as the unix
+	// epoch.
+	AddStartTimeNs int64 `protobuf:"varint,2,opt,name=add_start_time_ns,json=addStartTimeNs,proto3" json:"add_start_time_ns,omitempty"`
+	// The exclusive end time of the export. If this value is zero, it will be
+	// assumed to be the current time.
+	AddEndTimeNs int64 `protobuf:"varint,3,opt,name=add_end_time_ns,json=addEndTimeNs,proto3" json:"add_end_time_ns,omitempty"`
 }
 
-func (x *UpdateLimitsResponse) Reset() {
-	*x = UpdateLimitsResponse{}
+func (x *ExportForwardingHistoryRequest) Reset() {
+	*x = ExportForwardingHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circuitbreaker_proto_msgTypes[5]
+		mi := &file_circuitbreaker_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateLimitsResponse) String() string {
+func (x *ExportForwardingHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLimitsResponse) ProtoMessage() {}
+func (*ExportForwardingHistoryRequest) ProtoMessage() {}
 
-func (x *UpdateLimitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_circuitbreaker_proto_msgTypes[5]
+func (x *ExportForwardingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,36 +3385,63 @@ func (x *UpdateLimitsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLimitsResponse.ProtoReflect.Descriptor instead.
-func (*UpdateLimitsResponse) Descriptor() ([]byte, []int) {
-	return file_circuitbreaker_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ExportForwardingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ExportForwardingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{41}
 }
 
-type UpdateDefaultLimitRequest struct {
+func (x *ExportForwardingHistoryRequest) GetNodeKey() string {
+	if x != nil {
+		return x.NodeKey
+	}
+	return ""
+}
+
+func (x *ExportForwardingHistoryRequest) GetAddStartTimeNs() int64 {
+	if x != nil {
+		return x.AddStartTimeNs
+	}
+	return 0
+}
+
+func (x *ExportForwardingHistoryRequest) GetAddEndTimeNs() int64 {
+	if x != nil {
+		return x.AddEndTimeNs
+	}
+	return 0
+}
+
+type ExportedForward struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit *Limit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Forward *Forward `protobuf:"bytes,1,opt,name=forward,proto3" json:"forward,omitempty"`
+	// The fee offered by the htlc. It is only earned if the htlc settled.
+	FeeMsat uint64 `protobuf:"varint,2,opt,name=fee_msat,json=feeMsat,proto3" json:"fee_msat,omitempty"`
+	// The time that the htlc was in flight. Zero if the add time is unknown.
+	HoldTimeNs        uint64 `protobuf:"varint,3,opt,name=hold_time_ns,json=holdTimeNs,proto3" json:"hold_time_ns,omitempty"`
+	IncomingPeerAlias string `protobuf:"bytes,4,opt,name=incoming_peer_alias,json=incomingPeerAlias,proto3" json:"incoming_peer_alias,omitempty"`
+	OutgoingPeerAlias string `protobuf:"bytes,5,opt,name=outgoing_peer_alias,json=outgoingPeerAlias,proto3" json:"outgoing_peer_alias,omitempty"`
 }
 
-func (x *UpdateDefaultLimitRequest) Reset() {
-	*x = UpdateDefaultLimitRequest{}
+func (x *ExportedForward) Reset() {
+	*x = ExportedForward{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circuitbreaker_proto_msgTypes[6]
+		mi := &file_circuitbreaker_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateDefaultLimitRequest) String() string {
+func (x *ExportedForward) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDefaultLimitRequest) ProtoMessage() {}
+func (*ExportedForward) ProtoMessage() {}
 
-func (x *UpdateDefaultLimitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_circuitbreaker_proto_msgTypes[6]
+func (x *ExportedForward) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -387,41 +3452,84 @@ func (x *UpdateDefaultLimitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDefaultLimitRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDefaultLimitRequest) Descriptor() ([]byte, []int) {
-	return file_circuitbreaker_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ExportedForward.ProtoReflect.Descriptor instead.
+func (*ExportedForward) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ExportedForward) GetForward() *Forward {
+	if x != nil {
+		return x.Forward
+	}
+	return nil
+}
+
+func (x *ExportedForward) GetFeeMsat() uint64 {
+	if x != nil {
+		return x.FeeMsat
+	}
+	return 0
+}
+
+func (x *ExportedForward) GetHoldTimeNs() uint64 {
+	if x != nil {
+		return x.HoldTimeNs
+	}
+	return 0
+}
+
+func (x *ExportedForward) GetIncomingPeerAlias() string {
+	if x != nil {
+		return x.IncomingPeerAlias
+	}
+	return ""
 }
 
-func (x *UpdateDefaultLimitRequest) GetLimit() *Limit {
+func (x *ExportedForward) GetOutgoingPeerAlias() string {
 	if x != nil {
-		return x.Limit
+		return x.OutgoingPeerAlias
 	}
-	return nil
+	return ""
 }
 
-type UpdateDefaultLimitResponse struct {
+type GetForwardingStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *UpdateDefaultLimitResponse) Reset() {
-	*x = UpdateDefaultLimitResponse{}
+	// The lnd node to act on. Can be left empty if only a single node is
+	// connected.
+	NodeKey     string      `protobuf:"bytes,1,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+	Granularity Granularity `protobuf:"varint,2,opt,name=granularity,proto3,enum=circuitbreaker.Granularity" json:"granularity,omitempty"`
+	// The inclusive start time of the first bucket. If this value is zero, it
+	// will be treated as the unix epoch.
+	StartTimeNs int64 `protobuf:"varint,3,opt,name=start_time_ns,json=startTimeNs,proto3" json:"start_time_ns,omitempty"`
+	// The exclusive end time of the last bucket. If this value is zero, it will
+	// be assumed to be the current time.
+	EndTimeNs int64 `protobuf:"varint,4,opt,name=end_time_ns,json=endTimeNs,proto3" json:"end_time_ns,omitempty"`
+	// If set, only return statistics for htlcs from this peer.
+	IncomingPeer string `protobuf:"bytes,5,opt,name=incoming_peer,json=incomingPeer,proto3" json:"incoming_peer,omitempty"`
+	// If set, only return statistics for htlcs to this peer.
+	OutgoingPeer string `protobuf:"bytes,6,opt,name=outgoing_peer,json=outgoingPeer,proto3" json:"outgoing_peer,omitempty"`
+}
+
+func (x *GetForwardingStatsRequest) Reset() {
+	*x = GetForwardingStatsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circuitbreaker_proto_msgTypes[7]
+		mi := &file_circuitbreaker_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateDefaultLimitResponse) String() string {
+func (x *GetForwardingStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDefaultLimitResponse) ProtoMessage() {}
+func (*GetForwardingStatsRequest) ProtoMessage() {}
 
-func (x *UpdateDefaultLimitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_circuitbreaker_proto_msgTypes[7]
+func (x *GetForwardingStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -432,75 +3540,78 @@ func (x *UpdateDefaultLimitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDefaultLimitResponse.ProtoReflect.Descriptor instead.
-func (*UpdateDefaultLimitResponse) Descriptor() ([]byte, []int) {
-	return file_circuitbreaker_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetForwardingStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetForwardingStatsRequest) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{43}
 }
 
-type ListLimitsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetForwardingStatsRequest) GetNodeKey() string {
+	if x != nil {
+		return x.NodeKey
+	}
+	return ""
 }
 
-func (x *ListLimitsRequest) Reset() {
-	*x = ListLimitsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_circuitbreaker_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetForwardingStatsRequest) GetGranularity() Granularity {
+	if x != nil {
+		return x.Granularity
 	}
+	return Granularity_GRANULARITY_HOUR
 }
 
-func (x *ListLimitsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetForwardingStatsRequest) GetStartTimeNs() int64 {
+	if x != nil {
+		return x.StartTimeNs
+	}
+	return 0
 }
 
-func (*ListLimitsRequest) ProtoMessage() {}
+func (x *GetForwardingStatsRequest) GetEndTimeNs() int64 {
+	if x != nil {
+		return x.EndTimeNs
+	}
+	return 0
+}
 
-func (x *ListLimitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_circuitbreaker_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetForwardingStatsRequest) GetIncomingPeer() string {
+	if x != nil {
+		return x.IncomingPeer
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListLimitsRequest.ProtoReflect.Descriptor instead.
-func (*ListLimitsRequest) Descriptor() ([]byte, []int) {
-	return file_circuitbreaker_proto_rawDescGZIP(), []int{8}
+func (x *GetForwardingStatsRequest) GetOutgoingPeer() string {
+	if x != nil {
+		return x.OutgoingPeer
+	}
+	return ""
 }
 
-type ListLimitsResponse struct {
+type GetForwardingStatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limits       []*NodeLimit `protobuf:"bytes,5,rep,name=limits,proto3" json:"limits,omitempty"`
-	DefaultLimit *Limit       `protobuf:"bytes,2,opt,name=default_limit,json=defaultLimit,proto3" json:"default_limit,omitempty"`
+	Stats []*ForwardingStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
 }
 
-func (x *ListLimitsResponse) Reset() {
-	*x = ListLimitsResponse{}
+func (x *GetForwardingStatsResponse) Reset() {
+	*x = GetForwardingStatsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circuitbreaker_proto_msgTypes[9]
+		mi := &file_circuitbreaker_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListLimitsResponse) String() string {
+func (x *GetForwardingStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLimitsResponse) ProtoMessage() {}
+func (*GetForwardingStatsResponse) ProtoMessage() {}
 
-func (x *ListLimitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_circuitbreaker_proto_msgTypes[9]
+func (x *GetForwardingStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -511,57 +3622,59 @@ func (x *ListLimitsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLimitsResponse.ProtoReflect.Descriptor instead.
-func (*ListLimitsResponse) Descriptor() ([]byte, []int) {
-	return file_circuitbreaker_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *ListLimitsResponse) GetLimits() []*NodeLimit {
-	if x != nil {
-		return x.Limits
-	}
-	return nil
+// Deprecated: Use GetForwardingStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetForwardingStatsResponse) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ListLimitsResponse) GetDefaultLimit() *Limit {
+func (x *GetForwardingStatsResponse) GetStats() []*ForwardingStats {
 	if x != nil {
-		return x.DefaultLimit
+		return x.Stats
 	}
 	return nil
 }
 
-type NodeLimit struct {
+type ForwardingStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node  string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Alias string `protobuf:"bytes,5,opt,name=alias,proto3" json:"alias,omitempty"`
-	// The default limit applies if limit is not set.
-	Limit            *Limit   `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Counter_1H       *Counter `protobuf:"bytes,3,opt,name=counter_1h,json=counter1h,proto3" json:"counter_1h,omitempty"`
-	Counter_24H      *Counter `protobuf:"bytes,4,opt,name=counter_24h,json=counter24h,proto3" json:"counter_24h,omitempty"`
-	QueueLen         int64    `protobuf:"varint,6,opt,name=queue_len,json=queueLen,proto3" json:"queue_len,omitempty"`
-	PendingHtlcCount int64    `protobuf:"varint,7,opt,name=pending_htlc_count,json=pendingHtlcCount,proto3" json:"pending_htlc_count,omitempty"`
-}
-
-func (x *NodeLimit) Reset() {
-	*x = NodeLimit{}
+	// The start of the bucket. Buckets are aligned to utc hours or days.
+	BucketStartNs int64  `protobuf:"varint,1,opt,name=bucket_start_ns,json=bucketStartNs,proto3" json:"bucket_start_ns,omitempty"`
+	IncomingPeer  string `protobuf:"bytes,2,opt,name=incoming_peer,json=incomingPeer,proto3" json:"incoming_peer,omitempty"`
+	OutgoingPeer  string `protobuf:"bytes,3,opt,name=outgoing_peer,json=outgoingPeer,proto3" json:"outgoing_peer,omitempty"`
+	SettledCount  uint64 `protobuf:"varint,4,opt,name=settled_count,json=settledCount,proto3" json:"settled_count,omitempty"`
+	FailedCount   uint64 `protobuf:"varint,5,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	// The total outgoing amount of the settled htlcs.
+	SettledAmountMsat uint64 `protobuf:"varint,6,opt,name=settled_amount_msat,json=settledAmountMsat,proto3" json:"settled_amount_msat,omitempty"`
+	// The total fee earned with the settled htlcs.
+	FeesMsat uint64 `protobuf:"varint,7,opt,name=fees_msat,json=feesMsat,proto3" json:"fees_msat,omitempty"`
+	// The total time that htlcs were in flight. Htlcs with an unknown add time
+	// are not included.
+	HoldTimeNs uint64 `protobuf:"varint,8,opt,name=hold_time_ns,json=holdTimeNs,proto3" json:"hold_time_ns,omitempty"`
+	// The number of htlcs that are included in hold_time_ns.
+	HoldTimeCount uint64 `protobuf:"varint,9,opt,name=hold_time_count,json=holdTimeCount,proto3" json:"hold_time_count,omitempty"`
+	// The average time that the htlcs with a known add time were in flight.
+	AvgHoldTimeNs uint64 `protobuf:"varint,10,opt,name=avg_hold_time_ns,json=avgHoldTimeNs,proto3" json:"avg_hold_time_ns,omitempty"`
+}
+
+func (x *ForwardingStats) Reset() {
+	*x = ForwardingStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circuitbreaker_proto_msgTypes[10]
+		mi := &file_circuitbreaker_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NodeLimit) String() string {
+func (x *ForwardingStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeLimit) ProtoMessage() {}
+func (*ForwardingStats) ProtoMessage() {}
 
-func (x *NodeLimit) ProtoReflect() protoreflect.Message {
-	mi := &file_circuitbreaker_proto_msgTypes[10]
+func (x *ForwardingStats) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -572,87 +3685,115 @@ func (x *NodeLimit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeLimit.ProtoReflect.Descriptor instead.
-func (*NodeLimit) Descriptor() ([]byte, []int) {
-	return file_circuitbreaker_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ForwardingStats.ProtoReflect.Descriptor instead.
+func (*ForwardingStats) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *NodeLimit) GetNode() string {
+func (x *ForwardingStats) GetBucketStartNs() int64 {
 	if x != nil {
-		return x.Node
+		return x.BucketStartNs
+	}
+	return 0
+}
+
+func (x *ForwardingStats) GetIncomingPeer() string {
+	if x != nil {
+		return x.IncomingPeer
 	}
 	return ""
 }
 
-func (x *NodeLimit) GetAlias() string {
+func (x *ForwardingStats) GetOutgoingPeer() string {
 	if x != nil {
-		return x.Alias
+		return x.OutgoingPeer
 	}
 	return ""
 }
 
-func (x *NodeLimit) GetLimit() *Limit {
+func (x *ForwardingStats) GetSettledCount() uint64 {
 	if x != nil {
-		return x.Limit
+		return x.SettledCount
 	}
-	return nil
+	return 0
 }
 
-func (x *NodeLimit) GetCounter_1H() *Counter {
+func (x *ForwardingStats) GetFailedCount() uint64 {
 	if x != nil {
-		return x.Counter_1H
+		return x.FailedCount
 	}
-	return nil
+	return 0
 }
 
-func (x *NodeLimit) GetCounter_24H() *Counter {
+func (x *ForwardingStats) GetSettledAmountMsat() uint64 {
 	if x != nil {
-		return x.Counter_24H
+		return x.SettledAmountMsat
 	}
-	return nil
+	return 0
 }
 
-func (x *NodeLimit) GetQueueLen() int64 {
+func (x *ForwardingStats) GetFeesMsat() uint64 {
 	if x != nil {
-		return x.QueueLen
+		return x.FeesMsat
 	}
 	return 0
 }
 
-func (x *NodeLimit) GetPendingHtlcCount() int64 {
+func (x *ForwardingStats) GetHoldTimeNs() uint64 {
 	if x != nil {
-		return x.PendingHtlcCount
+		return x.HoldTimeNs
 	}
 	return 0
 }
 
-type Limit struct {
+func (x *ForwardingStats) GetHoldTimeCount() uint64 {
+	if x != nil {
+		return x.HoldTimeCount
+	}
+	return 0
+}
+
+func (x *ForwardingStats) GetAvgHoldTimeNs() uint64 {
+	if x != nil {
+		return x.AvgHoldTimeNs
+	}
+	return 0
+}
+
+type ImportForwardingHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MaxHourlyRate int64 `protobuf:"varint,3,opt,name=max_hourly_rate,json=maxHourlyRate,proto3" json:"max_hourly_rate,omitempty"`
-	MaxPending    int64 `protobuf:"varint,5,opt,name=max_pending,json=maxPending,proto3" json:"max_pending,omitempty"`
-	Mode          Mode  `protobuf:"varint,6,opt,name=mode,proto3,enum=circuitbreaker.Mode" json:"mode,omitempty"`
+	// The lnd node to act on. Can be left empty if only a single node is
+	// connected.
+	NodeKey string `protobuf:"bytes,1,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+	// The start time of the forwards to import. If this value is zero, it
+	// will be treated as the unix epoch.
+	StartTimeNs int64 `protobuf:"varint,2,opt,name=start_time_ns,json=startTimeNs,proto3" json:"start_time_ns,omitempty"`
+	// The end time of the forwards to import. If this value is zero, it will
+	// be treated as the current time. Forwards that resolved after the first
+	// forward recorded by circuitbreaker are never imported.
+	EndTimeNs int64 `protobuf:"varint,3,opt,name=end_time_ns,json=endTimeNs,proto3" json:"end_time_ns,omitempty"`
 }
 
-func (x *Limit) Reset() {
-	*x = Limit{}
+func (x *ImportForwardingHistoryRequest) Reset() {
+	*x = ImportForwardingHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circuitbreaker_proto_msgTypes[11]
+		mi := &file_circuitbreaker_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Limit) String() string {
+func (x *ImportForwardingHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Limit) ProtoMessage() {}
+func (*ImportForwardingHistoryRequest) ProtoMessage() {}
 
-func (x *Limit) ProtoReflect() protoreflect.Message {
-	mi := &file_circuitbreaker_proto_msgTypes[11]
+func (x *ImportForwardingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -663,59 +3804,60 @@ func (x *Limit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Limit.ProtoReflect.Descriptor instead.
-func (*Limit) Descriptor() ([]byte, []int) {
-	return file_circuitbreaker_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ImportForwardingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ImportForwardingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *Limit) GetMaxHourlyRate() int64 {
+func (x *ImportForwardingHistoryRequest) GetNodeKey() string {
 	if x != nil {
-		return x.MaxHourlyRate
+		return x.NodeKey
 	}
-	return 0
+	return ""
 }
 
-func (x *Limit) GetMaxPending() int64 {
+func (x *ImportForwardingHistoryRequest) GetStartTimeNs() int64 {
 	if x != nil {
-		return x.MaxPending
+		return x.StartTimeNs
 	}
 	return 0
 }
 
-func (x *Limit) GetMode() Mode {
+func (x *ImportForwardingHistoryRequest) GetEndTimeNs() int64 {
 	if x != nil {
-		return x.Mode
+		return x.EndTimeNs
 	}
-	return Mode_MODE_FAIL
+	return 0
 }
 
-type Counter struct {
+type ImportForwardingHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fail    int64 `protobuf:"varint,1,opt,name=fail,proto3" json:"fail,omitempty"`
-	Success int64 `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Reject  int64 `protobuf:"varint,3,opt,name=reject,proto3" json:"reject,omitempty"`
+	Imported uint64 `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	// The number of forwards that were already present, overlap with the
+	// recorded history or are over a channel that is unknown.
+	Skipped uint64 `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
 }
 
-func (x *Counter) Reset() {
-	*x = Counter{}
+func (x *ImportForwardingHistoryResponse) Reset() {
+	*x = ImportForwardingHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circuitbreaker_proto_msgTypes[12]
+		mi := &file_circuitbreaker_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Counter) String() string {
+func (x *ImportForwardingHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Counter) ProtoMessage() {}
+func (*ImportForwardingHistoryResponse) ProtoMessage() {}
 
-func (x *Counter) ProtoReflect() protoreflect.Message {
-	mi := &file_circuitbreaker_proto_msgTypes[12]
+func (x *ImportForwardingHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,62 +3868,48 @@ func (x *Counter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Counter.ProtoReflect.Descriptor instead.
-func (*Counter) Descriptor() ([]byte, []int) {
-	return file_circuitbreaker_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *Counter) GetFail() int64 {
-	if x != nil {
-		return x.Fail
-	}
-	return 0
+// Deprecated: Use ImportForwardingHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ImportForwardingHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *Counter) GetSuccess() int64 {
+func (x *ImportForwardingHistoryResponse) GetImported() uint64 {
 	if x != nil {
-		return x.Success
+		return x.Imported
 	}
 	return 0
 }
 
-func (x *Counter) GetReject() int64 {
+func (x *ImportForwardingHistoryResponse) GetSkipped() uint64 {
 	if x != nil {
-		return x.Reject
+		return x.Skipped
 	}
 	return 0
 }
 
-type ListForwardingHistoryRequest struct {
+type BackupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// The inclusive start time for the query, used to filter HTLCs by the time they were added
-	// to local incoming channel. If this value is zero, it will be treated as the unix epoch.
-	AddStartTimeNs int64 `protobuf:"varint,1,opt,name=add_start_time_ns,json=addStartTimeNs,proto3" json:"add_start_time_ns,omitempty"`
-	// The exclusive end time for the query, used to filter HTLCs by the time they were added to
-	// the local incoming channel. If this value is zero, it will be assumed to be the current time.
-	AddEndTimeNs int64 `protobuf:"varint,2,opt,name=add_end_time_ns,json=addEndTimeNs,proto3" json:"add_end_time_ns,omitempty"`
 }
 
-func (x *ListForwardingHistoryRequest) Reset() {
-	*x = ListForwardingHistoryRequest{}
+func (x *BackupRequest) Reset() {
+	*x = BackupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circuitbreaker_proto_msgTypes[13]
+		mi := &file_circuitbreaker_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListForwardingHistoryRequest) String() string {
+func (x *BackupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListForwardingHistoryRequest) ProtoMessage() {}
+func (*BackupRequest) ProtoMessage() {}
 
-func (x *ListForwardingHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_circuitbreaker_proto_msgTypes[13]
+func (x *BackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -792,50 +3920,37 @@ func (x *ListForwardingHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListForwardingHistoryRequest.ProtoReflect.Descriptor instead.
-func (*ListForwardingHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_circuitbreaker_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *ListForwardingHistoryRequest) GetAddStartTimeNs() int64 {
-	if x != nil {
-		return x.AddStartTimeNs
-	}
-	return 0
-}
-
-func (x *ListForwardingHistoryRequest) GetAddEndTimeNs() int64 {
-	if x != nil {
-		return x.AddEndTimeNs
-	}
-	return 0
+// Deprecated: Use BackupRequest.ProtoReflect.Descriptor instead.
+func (*BackupRequest) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{48}
 }
 
-type ListForwardingHistoryResponse struct {
+type BackupChunk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Forwards []*Forward `protobuf:"bytes,1,rep,name=forwards,proto3" json:"forwards,omitempty"`
+	// The next part of the database snapshot.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *ListForwardingHistoryResponse) Reset() {
-	*x = ListForwardingHistoryResponse{}
+func (x *BackupChunk) Reset() {
+	*x = BackupChunk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circuitbreaker_proto_msgTypes[14]
+		mi := &file_circuitbreaker_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListForwardingHistoryResponse) String() string {
+func (x *BackupChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListForwardingHistoryResponse) ProtoMessage() {}
+func (*BackupChunk) ProtoMessage() {}
 
-func (x *ListForwardingHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_circuitbreaker_proto_msgTypes[14]
+func (x *BackupChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,109 +3961,117 @@ func (x *ListForwardingHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListForwardingHistoryResponse.ProtoReflect.Descriptor instead.
-func (*ListForwardingHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_circuitbreaker_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use BackupChunk.ProtoReflect.Descriptor instead.
+func (*BackupChunk) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ListForwardingHistoryResponse) GetForwards() []*Forward {
+func (x *BackupChunk) GetData() []byte {
 	if x != nil {
-		return x.Forwards
+		return x.Data
 	}
 	return nil
 }
 
-type CircuitKey struct {
+type SubscribeHtlcDecisionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ShortChannelId uint64 `protobuf:"varint,1,opt,name=short_channel_id,json=shortChannelId,proto3" json:"short_channel_id,omitempty"`
-	HtlcIndex      uint32 `protobuf:"varint,2,opt,name=htlc_index,json=htlcIndex,proto3" json:"htlc_index,omitempty"`
+	// The lnd node to act on. Can be left empty if only a single node is
+	// connected.
+	NodeKey string `protobuf:"bytes,1,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
 }
 
-func (x *CircuitKey) Reset() {
-	*x = CircuitKey{}
+func (x *SubscribeHtlcDecisionsRequest) Reset() {
+	*x = SubscribeHtlcDecisionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circuitbreaker_proto_msgTypes[15]
+		mi := &file_circuitbreaker_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CircuitKey) String() string {
+func (x *SubscribeHtlcDecisionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CircuitKey) ProtoMessage() {}
+func (*SubscribeHtlcDecisionsRequest) ProtoMessage() {}
 
-func (x *CircuitKey) ProtoReflect() protoreflect.Message {
-	mi := &file_circuitbreaker_proto_msgTypes[15]
+func (x *SubscribeHtlcDecisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CircuitKey.ProtoReflect.Descriptor instead.
-func (*CircuitKey) Descriptor() ([]byte, []int) {
-	return file_circuitbreaker_proto_rawDescGZIP(), []int{15}
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *CircuitKey) GetShortChannelId() uint64 {
-	if x != nil {
-		return x.ShortChannelId
-	}
-	return 0
+// Deprecated: Use SubscribeHtlcDecisionsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeHtlcDecisionsRequest) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *CircuitKey) GetHtlcIndex() uint32 {
+func (x *SubscribeHtlcDecisionsRequest) GetNodeKey() string {
 	if x != nil {
-		return x.HtlcIndex
+		return x.NodeKey
 	}
-	return 0
+	return ""
 }
 
-type Forward struct {
+type HtlcDecision struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The time that the htlc was added to the incoming channel. Note that this value
-	// may be zero if circuitbreaker restarted while the htlc was in flight - this
-	// indicates that the value is unknown.
-	AddTimeNs       uint64      `protobuf:"varint,1,opt,name=add_time_ns,json=addTimeNs,proto3" json:"add_time_ns,omitempty"`
-	ResolveTimeNs   uint64      `protobuf:"varint,2,opt,name=resolve_time_ns,json=resolveTimeNs,proto3" json:"resolve_time_ns,omitempty"`
-	Settled         bool        `protobuf:"varint,3,opt,name=settled,proto3" json:"settled,omitempty"`
-	IncomingAmount  uint64      `protobuf:"varint,4,opt,name=incoming_amount,json=incomingAmount,proto3" json:"incoming_amount,omitempty"`
-	OutgoingAmount  uint64      `protobuf:"varint,5,opt,name=outgoing_amount,json=outgoingAmount,proto3" json:"outgoing_amount,omitempty"`
-	IncomingPeer    string      `protobuf:"bytes,6,opt,name=incoming_peer,json=incomingPeer,proto3" json:"incoming_peer,omitempty"`
-	IncomingCircuit *CircuitKey `protobuf:"bytes,7,opt,name=incoming_circuit,json=incomingCircuit,proto3" json:"incoming_circuit,omitempty"`
-	OutgoingPeer    string      `protobuf:"bytes,8,opt,name=outgoing_peer,json=outgoingPeer,proto3" json:"outgoing_peer,omitempty"`
-	OutgoingCircuit *CircuitKey `protobuf:"bytes,9,opt,name=outgoing_circuit,json=outgoingCircuit,proto3" json:"outgoing_circuit,omitempty"`
-}
-
-func (x *Forward) Reset() {
-	*x = Forward{}
+	TimeNs uint64       `protobuf:"varint,1,opt,name=time_ns,json=timeNs,proto3" json:"time_ns,omitempty"`
+	Type   DecisionType `protobuf:"varint,2,opt,name=type,proto3,enum=circuitbreaker.DecisionType" json:"type,omitempty"`
+	// The limit that was exceeded for rejected and queued htlcs.
+	Reason          RejectReason `protobuf:"varint,3,opt,name=reason,proto3,enum=circuitbreaker.RejectReason" json:"reason,omitempty"`
+	IncomingPeer    string       `protobuf:"bytes,4,opt,name=incoming_peer,json=incomingPeer,proto3" json:"incoming_peer,omitempty"`
+	IncomingCircuit *CircuitKey  `protobuf:"bytes,5,opt,name=incoming_circuit,json=incomingCircuit,proto3" json:"incoming_circuit,omitempty"`
+	// The outgoing peer is only set for resolutions, and may be empty if the
+	// outgoing channel is unknown.
+	OutgoingPeer string `protobuf:"bytes,6,opt,name=outgoing_peer,json=outgoingPeer,proto3" json:"outgoing_peer,omitempty"`
+	// For decisions, only the requested outgoing channel is set.
+	OutgoingCircuit *CircuitKey `protobuf:"bytes,7,opt,name=outgoing_circuit,json=outgoingCircuit,proto3" json:"outgoing_circuit,omitempty"`
+	IncomingAmount  uint64      `protobuf:"varint,8,opt,name=incoming_amount,json=incomingAmount,proto3" json:"incoming_amount,omitempty"`
+	OutgoingAmount  uint64      `protobuf:"varint,9,opt,name=outgoing_amount,json=outgoingAmount,proto3" json:"outgoing_amount,omitempty"`
+	// The position of a queued htlc in the queue, starting at one for the
+	// next htlc to be forwarded.
+	QueuePosition uint32 `protobuf:"varint,10,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`
+	// Set for forwards of htlcs that were queued.
+	Dequeued bool `protobuf:"varint,11,opt,name=dequeued,proto3" json:"dequeued,omitempty"`
+	// Set for decisions on queued htlcs that were made by an operator
+	// rather than the limits.
+	Operator bool `protobuf:"varint,14,opt,name=operator,proto3" json:"operator,omitempty"`
+	// Set for resolutions of settled htlcs.
+	Settled bool `protobuf:"varint,12,opt,name=settled,proto3" json:"settled,omitempty"`
+	// The number of decisions that were dropped since the previous decision
+	// was delivered, because the subscriber didn't keep up.
+	Missed uint64 `protobuf:"varint,13,opt,name=missed,proto3" json:"missed,omitempty"`
+}
+
+func (x *HtlcDecision) Reset() {
+	*x = HtlcDecision{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circuitbreaker_proto_msgTypes[16]
+		mi := &file_circuitbreaker_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Forward) String() string {
+func (x *HtlcDecision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Forward) ProtoMessage() {}
+func (*HtlcDecision) ProtoMessage() {}
 
-func (x *Forward) ProtoReflect() protoreflect.Message {
-	mi := &file_circuitbreaker_proto_msgTypes[16]
+func (x *HtlcDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_circuitbreaker_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,72 +4082,107 @@ func (x *Forward) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Forward.ProtoReflect.Descriptor instead.
-func (*Forward) Descriptor() ([]byte, []int) {
-	return file_circuitbreaker_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use HtlcDecision.ProtoReflect.Descriptor instead.
+func (*HtlcDecision) Descriptor() ([]byte, []int) {
+	return file_circuitbreaker_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *Forward) GetAddTimeNs() uint64 {
+func (x *HtlcDecision) GetTimeNs() uint64 {
 	if x != nil {
-		return x.AddTimeNs
+		return x.TimeNs
 	}
 	return 0
 }
 
-func (x *Forward) GetResolveTimeNs() uint64 {
+func (x *HtlcDecision) GetType() DecisionType {
 	if x != nil {
-		return x.ResolveTimeNs
+		return x.Type
 	}
-	return 0
+	return DecisionType_DECISION_TYPE_FORWARD
 }
 
-func (x *Forward) GetSettled() bool {
+func (x *HtlcDecision) GetReason() RejectReason {
 	if x != nil {
-		return x.Settled
+		return x.Reason
 	}
-	return false
+	return RejectReason_REJECT_REASON_NONE
 }
 
-func (x *Forward) GetIncomingAmount() uint64 {
+func (x *HtlcDecision) GetIncomingPeer() string {
+	if x != nil {
+		return x.IncomingPeer
+	}
+	return ""
+}
+
+func (x *HtlcDecision) GetIncomingCircuit() *CircuitKey {
+	if x != nil {
+		return x.IncomingCircuit
+	}
+	return nil
+}
+
+func (x *HtlcDecision) GetOutgoingPeer() string {
+	if x != nil {
+		return x.OutgoingPeer
+	}
+	return ""
+}
+
+func (x *HtlcDecision) GetOutgoingCircuit() *CircuitKey {
+	if x != nil {
+		return x.OutgoingCircuit
+	}
+	return nil
+}
+
+func (x *HtlcDecision) GetIncomingAmount() uint64 {
 	if x != nil {
 		return x.IncomingAmount
 	}
 	return 0
 }
 
-func (x *Forward) GetOutgoingAmount() uint64 {
+func (x *HtlcDecision) GetOutgoingAmount() uint64 {
 	if x != nil {
 		return x.OutgoingAmount
 	}
 	return 0
 }
 
-func (x *Forward) GetIncomingPeer() string {
+func (x *HtlcDecision) GetQueuePosition() uint32 {
 	if x != nil {
-		return x.IncomingPeer
+		return x.QueuePosition
 	}
-	return ""
+	return 0
 }
 
-func (x *Forward) GetIncomingCircuit() *CircuitKey {
+func (x *HtlcDecision) GetDequeued() bool {
 	if x != nil {
-		return x.IncomingCircuit
+		return x.Dequeued
 	}
-	return nil
+	return false
 }
 
-func (x *Forward) GetOutgoingPeer() string {
+func (x *HtlcDecision) GetOperator() bool {
 	if x != nil {
-		return x.OutgoingPeer
+		return x.Operator
 	}
-	return ""
+	return false
 }
 
-func (x *Forward) GetOutgoingCircuit() *CircuitKey {
+func (x *HtlcDecision) GetSettled() bool {
 	if x != nil {
-		return x.OutgoingCircuit
+		return x.Settled
 	}
-	return nil
+	return false
+}
+
+func (x *HtlcDecision) GetMissed() uint64 {
+	if x != nil {
+		return x.Missed
+	}
+	return 0
 }
 
 var File_circuitbreaker_proto protoreflect.FileDescriptor
@@ -1034,179 +4192,736 @@ var file_circuitbreaker_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62,
 	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
 	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f,
-	0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f,
-	0x64, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x6c,
-	0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x41,
-	0x6c, 0x69, 0x61, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x22, 0x2a, 0x0a, 0x12, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4b, 0x65,
+	0x79, 0x22, 0xa4, 0x02, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x18,
+	0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x73,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15,
+	0x68, 0x74, 0x6c, 0x63, 0x41, 0x75, 0x74, 0x6f, 0x46, 0x61, 0x69, 0x6c, 0x53, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x73, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4f,
+	0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x86,
+	0x02, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x31, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x31, 0x68, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x32, 0x34,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69,
+	0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x52, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x32, 0x34, 0x68, 0x12, 0x1b, 0x0a, 0x09,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x74,
+	0x6c, 0x63, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc7, 0x02, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65,
+	0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65,
+	0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x65, 0x65, 0x72, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x5f,
+	0x31, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x31, 0x68, 0x12, 0x38, 0x0a, 0x0b,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x32, 0x34, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x32, 0x34, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f,
+	0x6c, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x4c, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x68,
+	0x74, 0x6c, 0x63, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x74, 0x6c, 0x63, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x45, 0x0a, 0x12, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x15, 0x0a,
-	0x13, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x06,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63,
-	0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x73, 0x1a, 0x50, 0x0a, 0x0b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62,
-	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x48, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x69,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x6c, 0x65, 0x61,
+	0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xcb, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69,
+	0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x1a, 0x50, 0x0a, 0x0b, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x69,
 	0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x1c, 0x0a, 0x1a, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x83, 0x01, 0x0a,
-	0x12, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65,
-	0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x06,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x22, 0x9f, 0x02, 0x0a, 0x09, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x6c, 0x69,
+	0x69, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x16, 0x0a,
+	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x63, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x1c, 0x0a, 0x1a, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x83, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x31, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6c, 0x69,
 	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x69, 0x72, 0x63,
 	0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x65, 0x72, 0x5f, 0x31, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x69,
-	0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x31, 0x68, 0x12,
-	0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x32, 0x34, 0x68, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72,
-	0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x32, 0x34, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75,
-	0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x74, 0x6c, 0x63, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7a, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a,
-	0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x48, 0x6f, 0x75, 0x72, 0x6c,
-	0x79, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72,
-	0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x22, 0x4f, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66,
-	0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x61, 0x69, 0x6c, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6a,
-	0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63,
-	0x74, 0x22, 0x70, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x9f,
+	0x02, 0x0a, 0x09, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x31,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69,
+	0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x31, 0x68, 0x12, 0x38, 0x0a, 0x0b, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x32, 0x34, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x32, 0x34, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x6c,
+	0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4c,
+	0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x74,
+	0x6c, 0x63, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x74, 0x6c, 0x63, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x66, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65,
+	0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x0b, 0x50, 0x65, 0x65,
+	0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x32, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1c, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x22, 0x80, 0x02, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x48, 0x74, 0x6c,
+	0x63, 0x12, 0x45, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x69, 0x72,
+	0x63, 0x75, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x67,
+	0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e,
+	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x6e,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x4e, 0x73,
+	0x12, 0x15, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x61, 0x67, 0x65, 0x4e, 0x73, 0x22, 0x97, 0x02, 0x0a, 0x12, 0x50, 0x65, 0x65, 0x72,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x65, 0x65,
+	0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x65,
+	0x65, 0x73, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x27, 0x0a, 0x10, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x70, 0x35, 0x30, 0x5f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x68, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x35, 0x30, 0x4e, 0x73, 0x12,
+	0x27, 0x0a, 0x10, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x39, 0x30,
+	0x5f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x68, 0x6f, 0x6c, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x50, 0x39, 0x30, 0x4e, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x68, 0x6f, 0x6c, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x39, 0x39, 0x5f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x68, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x39, 0x39, 0x4e,
+	0x73, 0x22, 0xac, 0x01, 0x0a, 0x09, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x73,
+	0x12, 0x3e, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
+	0x12, 0x3e, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67,
+	0x22, 0xad, 0x04, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x2b, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63,
+	0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x31, 0x68, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x09,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x31, 0x68, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x5f, 0x32, 0x34, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x32, 0x34, 0x68, 0x12, 0x3d, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x68,
+	0x74, 0x6c, 0x63, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x69, 0x72,
+	0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x65, 0x65, 0x72,
+	0x48, 0x74, 0x6c, 0x63, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x74, 0x6c,
+	0x63, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x68, 0x74, 0x6c,
+	0x63, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x48, 0x74,
+	0x6c, 0x63, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x48, 0x74, 0x6c, 0x63, 0x73, 0x12,
+	0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e,
+	0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x22, 0x47, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x48, 0x74,
+	0x6c, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f,
+	0x64, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x65, 0x0a, 0x09, 0x50, 0x65, 0x65,
+	0x72, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73,
+	0x12, 0x2e, 0x0a, 0x05, 0x68, 0x74, 0x6c, 0x63, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x48, 0x74, 0x6c, 0x63, 0x52, 0x05, 0x68, 0x74, 0x6c, 0x63, 0x73,
+	0x22, 0x4c, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x48, 0x74,
+	0x6c, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x65, 0x65,
+	0x72, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x22, 0xb1,
+	0x01, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64,
+	0x48, 0x74, 0x6c, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e,
+	0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x45, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69,
+	0x6e, 0x67, 0x5f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x0f, 0x69, 0x6e,
+	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x12, 0x33, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x64, 0x48, 0x74, 0x6c, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x77, 0x0a, 0x11, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x30, 0x0a, 0x12, 0x46, 0x6c, 0x75, 0x73,
+	0x68, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x22, 0x5e, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xd5, 0x01, 0x0a, 0x11, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x4e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69,
+	0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x04,
+	0x68, 0x74, 0x6c, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x69, 0x72,
+	0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x65, 0x65, 0x72,
+	0x48, 0x74, 0x6c, 0x63, 0x52, 0x04, 0x68, 0x74, 0x6c, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c,
+	0x65, 0x72, 0x22, 0x57, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4b,
+	0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x51, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x22, 0x82, 0x02, 0x0a, 0x0b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x5f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x6f,
+	0x6c, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x32, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x12, 0x52, 0x65, 0x76,
+	0x65, 0x72, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x43,
+	0x0a, 0x13, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x49, 0x64, 0x22, 0x7a, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0f,
+	0x6d, 0x61, 0x78, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22,
+	0x4f, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x61,
+	0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x61, 0x69, 0x6c, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x22, 0xa6, 0x03, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
 	0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x29, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
 	0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x64,
 	0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4e, 0x73, 0x12, 0x25, 0x0a, 0x0f,
 	0x61, 0x64, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x73, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x64, 0x64, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d,
-	0x65, 0x4e, 0x73, 0x22, 0x54, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61,
-	0x72, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
-	0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52,
-	0x08, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x55, 0x0a, 0x0a, 0x43, 0x69, 0x72,
-	0x63, 0x75, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x68, 0x6f, 0x72, 0x74,
-	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x68, 0x74, 0x6c, 0x63, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x22, 0x95, 0x03, 0x0a, 0x07, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0b,
-	0x61, 0x64, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x61, 0x64, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x4e, 0x73, 0x12, 0x26, 0x0a, 0x0f,
-	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x4e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x12, 0x27,
-	0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
-	0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x67, 0x6f,
-	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0e, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x65,
-	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
-	0x67, 0x50, 0x65, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
-	0x67, 0x5f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
-	0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x0f, 0x69, 0x6e, 0x63,
-	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x12, 0x23, 0x0a, 0x0d,
-	0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x65,
-	0x72, 0x12, 0x45, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69,
-	0x72, 0x63, 0x75, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x69,
-	0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x69, 0x72,
-	0x63, 0x75, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e,
-	0x67, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x2a, 0x54, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65,
-	0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x00, 0x12,
-	0x0e, 0x0a, 0x0a, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0x01, 0x12,
-	0x1d, 0x0a, 0x19, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x50, 0x45,
-	0x45, 0x52, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0e,
-	0x0a, 0x0a, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x03, 0x32, 0xd2,
-	0x05, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x07, 0x47, 0x65,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62,
-	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62,
-	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x07, 0x12, 0x05,
-	0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x73, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62,
-	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x69, 0x72,
-	0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x6f, 0x0a, 0x0b, 0x43, 0x6c,
-	0x65, 0x61, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x69, 0x72, 0x63,
-	0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x43,
-	0x6c, 0x65, 0x61, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f,
-	0x63, 0x6c, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x8b, 0x01, 0x0a, 0x12,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x29, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61,
-	0x6b, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
-	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x55,
+	0x65, 0x4e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x65, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6f, 0x6d,
+	0x69, 0x6e, 0x67, 0x50, 0x65, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x67, 0x6f,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69,
+	0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x12,
+	0x38, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09,
+	0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x75, 0x0a, 0x1d, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x66, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63,
+	0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x08, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0x55, 0x0a, 0x0a, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x28,
+	0x0a, 0x10, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x74, 0x6c, 0x63,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x68, 0x74,
+	0x6c, 0x63, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xcc, 0x03, 0x0a, 0x07, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x64, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x4e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e,
+	0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6f, 0x6d,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x10,
+	0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x4b,
+	0x65, 0x79, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x69, 0x72, 0x63,
+	0x75, 0x69, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x65, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x67,
+	0x6f, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x67,
+	0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x0f,
+	0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x12,
+	0x35, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x1e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64,
+	0x65, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x61, 0x64, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4e, 0x73, 0x12,
+	0x25, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x64, 0x64, 0x45, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x4e, 0x73, 0x22, 0xe1, 0x01, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x66, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x66, 0x65, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x6c, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x68, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x4e, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e,
+	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x50, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x75,
+	0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e,
+	0x67, 0x50, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x83, 0x02, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65,
+	0x4b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c,
+	0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x4e, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x4e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69,
+	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6f,
+	0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x65, 0x72,
+	0x22, 0x53, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x8b, 0x03, 0x0a, 0x0f, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4e,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69,
+	0x6e, 0x67, 0x50, 0x65, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f,
+	0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
+	0x73, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x65, 0x65, 0x73, 0x4d, 0x73, 0x61, 0x74,
+	0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x68, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x4e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x68, 0x6f, 0x6c,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x10, 0x61, 0x76,
+	0x67, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x76, 0x67, 0x48, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x4e, 0x73, 0x22, 0x7f, 0x0a, 0x1e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79,
+	0x12, 0x22, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x4e, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x4e, 0x73, 0x22, 0x57, 0x0a, 0x1f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x0f, 0x0a,
+	0x0d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x21,
+	0x0a, 0x0b, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x3a, 0x0a, 0x1d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x74,
+	0x6c, 0x63, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x22, 0xca, 0x04,
+	0x0a, 0x0c, 0x48, 0x74, 0x6c, 0x63, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17,
+	0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x74, 0x69, 0x6d, 0x65, 0x4e, 0x73, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x69, 0x72, 0x63,
+	0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
+	0x50, 0x65, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
+	0x5f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e,
+	0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6f,
+	0x6d, 0x69, 0x6e, 0x67, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6f,
+	0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x65, 0x72,
+	0x12, 0x45, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69, 0x72,
+	0x63, 0x75, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x69, 0x72,
+	0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x69, 0x72, 0x63,
+	0x75, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67,
+	0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6d,
+	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x67, 0x6f,
+	0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x2a, 0x54, 0x0a, 0x04, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10,
+	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10,
+	0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f,
+	0x50, 0x45, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x03,
+	0x2a, 0x3e, 0x0a, 0x0b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x18, 0x0a, 0x14, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
+	0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x49, 0x4d,
+	0x49, 0x54, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x50, 0x45, 0x45, 0x52, 0x10, 0x01,
+	0x2a, 0x5e, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x48,
+	0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x41, 0x43,
+	0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45,
+	0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x02,
+	0x2a, 0x58, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x14, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x51, 0x55, 0x45,
+	0x55, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52,
+	0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x02, 0x2a, 0x5e, 0x0a, 0x0d, 0x53, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x12, 0x53,
+	0x45, 0x54, 0x54, 0x4c, 0x45, 0x44, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x4c,
+	0x4c, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x44, 0x5f, 0x46,
+	0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x19, 0x0a, 0x15, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x44, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45,
+	0x52, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x40, 0x0a, 0x09, 0x53, 0x6f,
+	0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x4f, 0x52, 0x54, 0x5f,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x43, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x44, 0x45, 0x53, 0x43, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x2a, 0x40, 0x0a, 0x0d,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x17, 0x0a,
+	0x13, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
+	0x4c, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52,
+	0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4c, 0x4e, 0x44, 0x10, 0x01, 0x2a, 0x38,
+	0x0a, 0x0b, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a,
+	0x10, 0x47, 0x52, 0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x4f, 0x55,
+	0x52, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x52, 0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52, 0x49,
+	0x54, 0x59, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x01, 0x2a, 0x90, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x45, 0x43,
+	0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x57, 0x41,
+	0x52, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a,
+	0x14, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x45, 0x43, 0x49, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x03,
+	0x12, 0x19, 0x0a, 0x15, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x10, 0x04, 0x2a, 0xa4, 0x01, 0x0a, 0x0c,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12,
+	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x49,
+	0x4d, 0x49, 0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49,
+	0x54, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45,
+	0x4d, 0x50, 0x54, 0x59, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52,
+	0x10, 0x04, 0x32, 0xe1, 0x11, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x69,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x22, 0x2e,
+	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09,
+	0x2f, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x59, 0x0a, 0x07, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x07, 0x12, 0x05, 0x2f,
+	0x69, 0x6e, 0x66, 0x6f, 0x12, 0x73, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x69, 0x72, 0x63,
+	0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22, 0x0d, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x6f, 0x0a, 0x0b, 0x43, 0x6c, 0x65,
+	0x61, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63,
+	0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6c,
+	0x65, 0x61, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x63, 0x6c, 0x65, 0x61,
+	0x72, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x8b, 0x01, 0x0a, 0x12, 0x55,
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x64, 0x0a, 0x0a, 0x4c, 0x69, 0x73,
-	0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69,
-	0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x69, 0x72,
+	0x74, 0x12, 0x29, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63,
+	0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
+	0x22, 0x13, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x64, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x69, 0x72, 0x63,
+	0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x72,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12,
+	0x24, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x12, 0x79, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64,
+	0x48, 0x74, 0x6c, 0x63, 0x73, 0x12, 0x26, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x64, 0x48, 0x74, 0x6c, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x48, 0x74, 0x6c, 0x63, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d,
+	0x2f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x73, 0x12, 0x89, 0x01,
+	0x0a, 0x11, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x48,
+	0x74, 0x6c, 0x63, 0x12, 0x28, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x64, 0x48, 0x74, 0x6c, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x48, 0x74, 0x6c, 0x63,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
+	0x22, 0x14, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x64, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x3a, 0x01, 0x2a, 0x12, 0x6c, 0x0a, 0x0a, 0x46, 0x6c, 0x75,
+	0x73, 0x68, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x21, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69,
+	0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x69, 0x72,
+	0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x46, 0x6c, 0x75, 0x73,
+	0x68, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x5f, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7d, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x63, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x7d, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x63, 0x69, 0x72,
 	0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12,
-	0x91, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69,
-	0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2c, 0x2e, 0x63, 0x69, 0x72, 0x63,
-	0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x6f, 0x0a, 0x0b, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x91, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x46,
 	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69,
-	0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72,
+	0x12, 0x2c, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
+	0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x6c, 0x0a, 0x17, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2e, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72,
 	0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13,
-	0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x79, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x65, 0x71, 0x75, 0x69, 0x70,
-	0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61,
-	0x6b, 0x65, 0x72, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b,
-	0x65, 0x72, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x30, 0x01, 0x12, 0x86, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x29, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x69, 0x72,
+	0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11,
+	0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0xa1, 0x01, 0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2e, 0x2e,
+	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x3a, 0x01, 0x2a, 0x12, 0x46, 0x0a, 0x06, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12,
+	0x1d, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x67, 0x0a,
+	0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x74, 0x6c, 0x63, 0x44, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69,
+	0x74, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x48, 0x74, 0x6c, 0x63, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x74, 0x6c, 0x63, 0x44, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x30, 0x01, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x65, 0x71,
+	0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1221,59 +4936,167 @@ func file_circuitbreaker_proto_rawDescGZIP() []byte {
 	return file_circuitbreaker_proto_rawDescData
 }
 
-var file_circuitbreaker_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_circuitbreaker_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_circuitbreaker_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_circuitbreaker_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_circuitbreaker_proto_goTypes = []interface{}{
-	(Mode)(0),                             // 0: circuitbreaker.Mode
-	(*GetInfoRequest)(nil),                // 1: circuitbreaker.GetInfoRequest
-	(*GetInfoResponse)(nil),               // 2: circuitbreaker.GetInfoResponse
-	(*ClearLimitsRequest)(nil),            // 3: circuitbreaker.ClearLimitsRequest
-	(*ClearLimitsResponse)(nil),           // 4: circuitbreaker.ClearLimitsResponse
-	(*UpdateLimitsRequest)(nil),           // 5: circuitbreaker.UpdateLimitsRequest
-	(*UpdateLimitsResponse)(nil),          // 6: circuitbreaker.UpdateLimitsResponse
-	(*UpdateDefaultLimitRequest)(nil),     // 7: circuitbreaker.UpdateDefaultLimitRequest
-	(*UpdateDefaultLimitResponse)(nil),    // 8: circuitbreaker.UpdateDefaultLimitResponse
-	(*ListLimitsRequest)(nil),             // 9: circuitbreaker.ListLimitsRequest
-	(*ListLimitsResponse)(nil),            // 10: circuitbreaker.ListLimitsResponse
-	(*NodeLimit)(nil),                     // 11: circuitbreaker.NodeLimit
-	(*Limit)(nil),                         // 12: circuitbreaker.Limit
-	(*Counter)(nil),                       // 13: circuitbreaker.Counter
-	(*ListForwardingHistoryRequest)(nil),  // 14: circuitbreaker.ListForwardingHistoryRequest
-	(*ListForwardingHistoryResponse)(nil), // 15: circuitbreaker.ListForwardingHistoryResponse
-	(*CircuitKey)(nil),                    // 16: circuitbreaker.CircuitKey
-	(*Forward)(nil),                       // 17: circuitbreaker.Forward
-	nil,                                   // 18: circuitbreaker.UpdateLimitsRequest.LimitsEntry
+	(Mode)(0),                               // 0: circuitbreaker.Mode
+	(LimitSource)(0),                        // 1: circuitbreaker.LimitSource
+	(ChannelState)(0),                       // 2: circuitbreaker.ChannelState
+	(QueueAction)(0),                        // 3: circuitbreaker.QueueAction
+	(SettledFilter)(0),                      // 4: circuitbreaker.SettledFilter
+	(SortOrder)(0),                          // 5: circuitbreaker.SortOrder
+	(ForwardSource)(0),                      // 6: circuitbreaker.ForwardSource
+	(Granularity)(0),                        // 7: circuitbreaker.Granularity
+	(DecisionType)(0),                       // 8: circuitbreaker.DecisionType
+	(RejectReason)(0),                       // 9: circuitbreaker.RejectReason
+	(*GetInfoRequest)(nil),                  // 10: circuitbreaker.GetInfoRequest
+	(*GetInfoResponse)(nil),                 // 11: circuitbreaker.GetInfoResponse
+	(*GetOverviewRequest)(nil),              // 12: circuitbreaker.GetOverviewRequest
+	(*GetOverviewResponse)(nil),             // 13: circuitbreaker.GetOverviewResponse
+	(*NodeOverview)(nil),                    // 14: circuitbreaker.NodeOverview
+	(*ClearLimitsRequest)(nil),              // 15: circuitbreaker.ClearLimitsRequest
+	(*ClearLimitsResponse)(nil),             // 16: circuitbreaker.ClearLimitsResponse
+	(*UpdateLimitsRequest)(nil),             // 17: circuitbreaker.UpdateLimitsRequest
+	(*UpdateLimitsResponse)(nil),            // 18: circuitbreaker.UpdateLimitsResponse
+	(*UpdateDefaultLimitRequest)(nil),       // 19: circuitbreaker.UpdateDefaultLimitRequest
+	(*UpdateDefaultLimitResponse)(nil),      // 20: circuitbreaker.UpdateDefaultLimitResponse
+	(*ListLimitsRequest)(nil),               // 21: circuitbreaker.ListLimitsRequest
+	(*ListLimitsResponse)(nil),              // 22: circuitbreaker.ListLimitsResponse
+	(*NodeLimit)(nil),                       // 23: circuitbreaker.NodeLimit
+	(*GetPeerDetailRequest)(nil),            // 24: circuitbreaker.GetPeerDetailRequest
+	(*PeerChannel)(nil),                     // 25: circuitbreaker.PeerChannel
+	(*PeerHtlc)(nil),                        // 26: circuitbreaker.PeerHtlc
+	(*PeerDirectionStats)(nil),              // 27: circuitbreaker.PeerDirectionStats
+	(*PeerStats)(nil),                       // 28: circuitbreaker.PeerStats
+	(*GetPeerDetailResponse)(nil),           // 29: circuitbreaker.GetPeerDetailResponse
+	(*ListQueuedHtlcsRequest)(nil),          // 30: circuitbreaker.ListQueuedHtlcsRequest
+	(*PeerQueue)(nil),                       // 31: circuitbreaker.PeerQueue
+	(*ListQueuedHtlcsResponse)(nil),         // 32: circuitbreaker.ListQueuedHtlcsResponse
+	(*ResolveQueuedHtlcRequest)(nil),        // 33: circuitbreaker.ResolveQueuedHtlcRequest
+	(*ResolveQueuedHtlcResponse)(nil),       // 34: circuitbreaker.ResolveQueuedHtlcResponse
+	(*FlushQueueRequest)(nil),               // 35: circuitbreaker.FlushQueueRequest
+	(*FlushQueueResponse)(nil),              // 36: circuitbreaker.FlushQueueResponse
+	(*ListQueueActionsRequest)(nil),         // 37: circuitbreaker.ListQueueActionsRequest
+	(*QueueActionRecord)(nil),               // 38: circuitbreaker.QueueActionRecord
+	(*ListQueueActionsResponse)(nil),        // 39: circuitbreaker.ListQueueActionsResponse
+	(*ListLimitChangesRequest)(nil),         // 40: circuitbreaker.ListLimitChangesRequest
+	(*ListLimitChangesResponse)(nil),        // 41: circuitbreaker.ListLimitChangesResponse
+	(*LimitChange)(nil),                     // 42: circuitbreaker.LimitChange
+	(*RevertLimitRequest)(nil),              // 43: circuitbreaker.RevertLimitRequest
+	(*RevertLimitResponse)(nil),             // 44: circuitbreaker.RevertLimitResponse
+	(*Limit)(nil),                           // 45: circuitbreaker.Limit
+	(*Counter)(nil),                         // 46: circuitbreaker.Counter
+	(*ListForwardingHistoryRequest)(nil),    // 47: circuitbreaker.ListForwardingHistoryRequest
+	(*ListForwardingHistoryResponse)(nil),   // 48: circuitbreaker.ListForwardingHistoryResponse
+	(*CircuitKey)(nil),                      // 49: circuitbreaker.CircuitKey
+	(*Forward)(nil),                         // 50: circuitbreaker.Forward
+	(*ExportForwardingHistoryRequest)(nil),  // 51: circuitbreaker.ExportForwardingHistoryRequest
+	(*ExportedForward)(nil),                 // 52: circuitbreaker.ExportedForward
+	(*GetForwardingStatsRequest)(nil),       // 53: circuitbreaker.GetForwardingStatsRequest
+	(*GetForwardingStatsResponse)(nil),      // 54: circuitbreaker.GetForwardingStatsResponse
+	(*ForwardingStats)(nil),                 // 55: circuitbreaker.ForwardingStats
+	(*ImportForwardingHistoryRequest)(nil),  // 56: circuitbreaker.ImportForwardingHistoryRequest
+	(*ImportForwardingHistoryResponse)(nil), // 57: circuitbreaker.ImportForwardingHistoryResponse
+	(*BackupRequest)(nil),                   // 58: circuitbreaker.BackupRequest
+	(*BackupChunk)(nil),                     // 59: circuitbreaker.BackupChunk
+	(*SubscribeHtlcDecisionsRequest)(nil),   // 60: circuitbreaker.SubscribeHtlcDecisionsRequest
+	(*HtlcDecision)(nil),                    // 61: circuitbreaker.HtlcDecision
+	nil,                                     // 62: circuitbreaker.UpdateLimitsRequest.LimitsEntry
 }
 var file_circuitbreaker_proto_depIdxs = []int32{
-	18, // 0: circuitbreaker.UpdateLimitsRequest.limits:type_name -> circuitbreaker.UpdateLimitsRequest.LimitsEntry
-	12, // 1: circuitbreaker.UpdateDefaultLimitRequest.limit:type_name -> circuitbreaker.Limit
-	11, // 2: circuitbreaker.ListLimitsResponse.limits:type_name -> circuitbreaker.NodeLimit
-	12, // 3: circuitbreaker.ListLimitsResponse.default_limit:type_name -> circuitbreaker.Limit
-	12, // 4: circuitbreaker.NodeLimit.limit:type_name -> circuitbreaker.Limit
-	13, // 5: circuitbreaker.NodeLimit.counter_1h:type_name -> circuitbreaker.Counter
-	13, // 6: circuitbreaker.NodeLimit.counter_24h:type_name -> circuitbreaker.Counter
-	0,  // 7: circuitbreaker.Limit.mode:type_name -> circuitbreaker.Mode
-	17, // 8: circuitbreaker.ListForwardingHistoryResponse.forwards:type_name -> circuitbreaker.Forward
-	16, // 9: circuitbreaker.Forward.incoming_circuit:type_name -> circuitbreaker.CircuitKey
-	16, // 10: circuitbreaker.Forward.outgoing_circuit:type_name -> circuitbreaker.CircuitKey
-	12, // 11: circuitbreaker.UpdateLimitsRequest.LimitsEntry.value:type_name -> circuitbreaker.Limit
-	1,  // 12: circuitbreaker.Service.GetInfo:input_type -> circuitbreaker.GetInfoRequest
-	5,  // 13: circuitbreaker.Service.UpdateLimits:input_type -> circuitbreaker.UpdateLimitsRequest
-	3,  // 14: circuitbreaker.Service.ClearLimits:input_type -> circuitbreaker.ClearLimitsRequest
-	7,  // 15: circuitbreaker.Service.UpdateDefaultLimit:input_type -> circuitbreaker.UpdateDefaultLimitRequest
-	9,  // 16: circuitbreaker.Service.ListLimits:input_type -> circuitbreaker.ListLimitsRequest
-	14, // 17: circuitbreaker.Service.ListForwardingHistory:input_type -> circuitbreaker.ListForwardingHistoryRequest
-	2,  // 18: circuitbreaker.Service.GetInfo:output_type -> circuitbreaker.GetInfoResponse
-	6,  // 19: circuitbreaker.Service.UpdateLimits:output_type -> circuitbreaker.UpdateLimitsResponse
-	4,  // 20: circuitbreaker.Service.ClearLimits:output_type -> circuitbreaker.ClearLimitsResponse
-	8,  // 21: circuitbreaker.Service.UpdateDefaultLimit:output_type -> circuitbreaker.UpdateDefaultLimitResponse
-	10, // 22: circuitbreaker.Service.ListLimits:output_type -> circuitbreaker.ListLimitsResponse
-	15, // 23: circuitbreaker.Service.ListForwardingHistory:output_type -> circuitbreaker.ListForwardingHistoryResponse
-	18, // [18:24] is the sub-list for method output_type
-	12, // [12:18] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	14, // 0: circuitbreaker.GetOverviewResponse.nodes:type_name -> circuitbreaker.NodeOverview
+	46, // 1: circuitbreaker.GetOverviewResponse.counter_1h:type_name -> circuitbreaker.Counter
+	46, // 2: circuitbreaker.GetOverviewResponse.counter_24h:type_name -> circuitbreaker.Counter
+	46, // 3: circuitbreaker.NodeOverview.counter_1h:type_name -> circuitbreaker.Counter
+	46, // 4: circuitbreaker.NodeOverview.counter_24h:type_name -> circuitbreaker.Counter
+	62, // 5: circuitbreaker.UpdateLimitsRequest.limits:type_name -> circuitbreaker.UpdateLimitsRequest.LimitsEntry
+	45, // 6: circuitbreaker.UpdateDefaultLimitRequest.limit:type_name -> circuitbreaker.Limit
+	23, // 7: circuitbreaker.ListLimitsResponse.limits:type_name -> circuitbreaker.NodeLimit
+	45, // 8: circuitbreaker.ListLimitsResponse.default_limit:type_name -> circuitbreaker.Limit
+	45, // 9: circuitbreaker.NodeLimit.limit:type_name -> circuitbreaker.Limit
+	46, // 10: circuitbreaker.NodeLimit.counter_1h:type_name -> circuitbreaker.Counter
+	46, // 11: circuitbreaker.NodeLimit.counter_24h:type_name -> circuitbreaker.Counter
+	2,  // 12: circuitbreaker.PeerChannel.state:type_name -> circuitbreaker.ChannelState
+	49, // 13: circuitbreaker.PeerHtlc.incoming_circuit:type_name -> circuitbreaker.CircuitKey
+	27, // 14: circuitbreaker.PeerStats.incoming:type_name -> circuitbreaker.PeerDirectionStats
+	27, // 15: circuitbreaker.PeerStats.outgoing:type_name -> circuitbreaker.PeerDirectionStats
+	25, // 16: circuitbreaker.GetPeerDetailResponse.channels:type_name -> circuitbreaker.PeerChannel
+	45, // 17: circuitbreaker.GetPeerDetailResponse.limit:type_name -> circuitbreaker.Limit
+	1,  // 18: circuitbreaker.GetPeerDetailResponse.limit_source:type_name -> circuitbreaker.LimitSource
+	46, // 19: circuitbreaker.GetPeerDetailResponse.counter_1h:type_name -> circuitbreaker.Counter
+	46, // 20: circuitbreaker.GetPeerDetailResponse.counter_24h:type_name -> circuitbreaker.Counter
+	26, // 21: circuitbreaker.GetPeerDetailResponse.pending_htlcs:type_name -> circuitbreaker.PeerHtlc
+	26, // 22: circuitbreaker.GetPeerDetailResponse.queued_htlcs:type_name -> circuitbreaker.PeerHtlc
+	28, // 23: circuitbreaker.GetPeerDetailResponse.stats:type_name -> circuitbreaker.PeerStats
+	26, // 24: circuitbreaker.PeerQueue.htlcs:type_name -> circuitbreaker.PeerHtlc
+	31, // 25: circuitbreaker.ListQueuedHtlcsResponse.queues:type_name -> circuitbreaker.PeerQueue
+	49, // 26: circuitbreaker.ResolveQueuedHtlcRequest.incoming_circuit:type_name -> circuitbreaker.CircuitKey
+	3,  // 27: circuitbreaker.ResolveQueuedHtlcRequest.action:type_name -> circuitbreaker.QueueAction
+	3,  // 28: circuitbreaker.FlushQueueRequest.action:type_name -> circuitbreaker.QueueAction
+	3,  // 29: circuitbreaker.QueueActionRecord.action:type_name -> circuitbreaker.QueueAction
+	26, // 30: circuitbreaker.QueueActionRecord.htlc:type_name -> circuitbreaker.PeerHtlc
+	38, // 31: circuitbreaker.ListQueueActionsResponse.actions:type_name -> circuitbreaker.QueueActionRecord
+	42, // 32: circuitbreaker.ListLimitChangesResponse.changes:type_name -> circuitbreaker.LimitChange
+	45, // 33: circuitbreaker.LimitChange.old_limit:type_name -> circuitbreaker.Limit
+	45, // 34: circuitbreaker.LimitChange.new_limit:type_name -> circuitbreaker.Limit
+	0,  // 35: circuitbreaker.Limit.mode:type_name -> circuitbreaker.Mode
+	4,  // 36: circuitbreaker.ListForwardingHistoryRequest.settled:type_name -> circuitbreaker.SettledFilter
+	5,  // 37: circuitbreaker.ListForwardingHistoryRequest.sort_order:type_name -> circuitbreaker.SortOrder
+	50, // 38: circuitbreaker.ListForwardingHistoryResponse.forwards:type_name -> circuitbreaker.Forward
+	49, // 39: circuitbreaker.Forward.incoming_circuit:type_name -> circuitbreaker.CircuitKey
+	49, // 40: circuitbreaker.Forward.outgoing_circuit:type_name -> circuitbreaker.CircuitKey
+	6,  // 41: circuitbreaker.Forward.source:type_name -> circuitbreaker.ForwardSource
+	50, // 42: circuitbreaker.ExportedForward.forward:type_name -> circuitbreaker.Forward
+	7,  // 43: circuitbreaker.GetForwardingStatsRequest.granularity:type_name -> circuitbreaker.Granularity
+	55, // 44: circuitbreaker.GetForwardingStatsResponse.stats:type_name -> circuitbreaker.ForwardingStats
+	8,  // 45: circuitbreaker.HtlcDecision.type:type_name -> circuitbreaker.DecisionType
+	9,  // 46: circuitbreaker.HtlcDecision.reason:type_name -> circuitbreaker.RejectReason
+	49, // 47: circuitbreaker.HtlcDecision.incoming_circuit:type_name -> circuitbreaker.CircuitKey
+	49, // 48: circuitbreaker.HtlcDecision.outgoing_circuit:type_name -> circuitbreaker.CircuitKey
+	45, // 49: circuitbreaker.UpdateLimitsRequest.LimitsEntry.value:type_name -> circuitbreaker.Limit
+	12, // 50: circuitbreaker.Service.GetOverview:input_type -> circuitbreaker.GetOverviewRequest
+	10, // 51: circuitbreaker.Service.GetInfo:input_type -> circuitbreaker.GetInfoRequest
+	17, // 52: circuitbreaker.Service.UpdateLimits:input_type -> circuitbreaker.UpdateLimitsRequest
+	15, // 53: circuitbreaker.Service.ClearLimits:input_type -> circuitbreaker.ClearLimitsRequest
+	19, // 54: circuitbreaker.Service.UpdateDefaultLimit:input_type -> circuitbreaker.UpdateDefaultLimitRequest
+	21, // 55: circuitbreaker.Service.ListLimits:input_type -> circuitbreaker.ListLimitsRequest
+	24, // 56: circuitbreaker.Service.GetPeerDetail:input_type -> circuitbreaker.GetPeerDetailRequest
+	30, // 57: circuitbreaker.Service.ListQueuedHtlcs:input_type -> circuitbreaker.ListQueuedHtlcsRequest
+	33, // 58: circuitbreaker.Service.ResolveQueuedHtlc:input_type -> circuitbreaker.ResolveQueuedHtlcRequest
+	35, // 59: circuitbreaker.Service.FlushQueue:input_type -> circuitbreaker.FlushQueueRequest
+	37, // 60: circuitbreaker.Service.ListQueueActions:input_type -> circuitbreaker.ListQueueActionsRequest
+	40, // 61: circuitbreaker.Service.ListLimitChanges:input_type -> circuitbreaker.ListLimitChangesRequest
+	43, // 62: circuitbreaker.Service.RevertLimit:input_type -> circuitbreaker.RevertLimitRequest
+	47, // 63: circuitbreaker.Service.ListForwardingHistory:input_type -> circuitbreaker.ListForwardingHistoryRequest
+	51, // 64: circuitbreaker.Service.ExportForwardingHistory:input_type -> circuitbreaker.ExportForwardingHistoryRequest
+	53, // 65: circuitbreaker.Service.GetForwardingStats:input_type -> circuitbreaker.GetForwardingStatsRequest
+	56, // 66: circuitbreaker.Service.ImportForwardingHistory:input_type -> circuitbreaker.ImportForwardingHistoryRequest
+	58, // 67: circuitbreaker.Service.Backup:input_type -> circuitbreaker.BackupRequest
+	60, // 68: circuitbreaker.Service.SubscribeHtlcDecisions:input_type -> circuitbreaker.SubscribeHtlcDecisionsRequest
+	13, // 69: circuitbreaker.Service.GetOverview:output_type -> circuitbreaker.GetOverviewResponse
+	11, // 70: circuitbreaker.Service.GetInfo:output_type -> circuitbreaker.GetInfoResponse
+	18, // 71: circuitbreaker.Service.UpdateLimits:output_type -> circuitbreaker.UpdateLimitsResponse
+	16, // 72: circuitbreaker.Service.ClearLimits:output_type -> circuitbreaker.ClearLimitsResponse
+	20, // 73: circuitbreaker.Service.UpdateDefaultLimit:output_type -> circuitbreaker.UpdateDefaultLimitResponse
+	22, // 74: circuitbreaker.Service.ListLimits:output_type -> circuitbreaker.ListLimitsResponse
+	29, // 75: circuitbreaker.Service.GetPeerDetail:output_type -> circuitbreaker.GetPeerDetailResponse
+	32, // 76: circuitbreaker.Service.ListQueuedHtlcs:output_type -> circuitbreaker.ListQueuedHtlcsResponse
+	34, // 77: circuitbreaker.Service.ResolveQueuedHtlc:output_type -> circuitbreaker.ResolveQueuedHtlcResponse
+	36, // 78: circuitbreaker.Service.FlushQueue:output_type -> circuitbreaker.FlushQueueResponse
+	39, // 79: circuitbreaker.Service.ListQueueActions:output_type -> circuitbreaker.ListQueueActionsResponse
+	41, // 80: circuitbreaker.Service.ListLimitChanges:output_type -> circuitbreaker.ListLimitChangesResponse
+	44, // 81: circuitbreaker.Service.RevertLimit:output_type -> circuitbreaker.RevertLimitResponse
+	48, // 82: circuitbreaker.Service.ListForwardingHistory:output_type -> circuitbreaker.ListForwardingHistoryResponse
+	52, // 83: circuitbreaker.Service.ExportForwardingHistory:output_type -> circuitbreaker.ExportedForward
+	54, // 84: circuitbreaker.Service.GetForwardingStats:output_type -> circuitbreaker.GetForwardingStatsResponse
+	57, // 85: circuitbreaker.Service.ImportForwardingHistory:output_type -> circuitbreaker.ImportForwardingHistoryResponse
+	59, // 86: circuitbreaker.Service.Backup:output_type -> circuitbreaker.BackupChunk
+	61, // 87: circuitbreaker.Service.SubscribeHtlcDecisions:output_type -> circuitbreaker.HtlcDecision
+	69, // [69:88] is the sub-list for method output_type
+	50, // [50:69] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
 }
 
 func init() { file_circuitbreaker_proto_init() }
@@ -1294,8 +5117,164 @@ func file_circuitbreaker_proto_init() {
 				return nil
 			}
 		}
-		file_circuitbreaker_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInfoResponse); i {
+		file_circuitbreaker_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOverviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOverviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeOverview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearLimitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearLimitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLimitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLimitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDefaultLimitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDefaultLimitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLimitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLimitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerDetailRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1306,8 +5285,8 @@ func file_circuitbreaker_proto_init() {
 				return nil
 			}
 		}
-		file_circuitbreaker_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClearLimitsRequest); i {
+		file_circuitbreaker_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerChannel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1318,8 +5297,8 @@ func file_circuitbreaker_proto_init() {
 				return nil
 			}
 		}
-		file_circuitbreaker_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClearLimitsResponse); i {
+		file_circuitbreaker_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerHtlc); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1330,8 +5309,8 @@ func file_circuitbreaker_proto_init() {
 				return nil
 			}
 		}
-		file_circuitbreaker_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLimitsRequest); i {
+		file_circuitbreaker_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerDirectionStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1342,8 +5321,8 @@ func file_circuitbreaker_proto_init() {
 				return nil
 			}
 		}
-		file_circuitbreaker_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLimitsResponse); i {
+		file_circuitbreaker_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1354,8 +5333,8 @@ func file_circuitbreaker_proto_init() {
 				return nil
 			}
 		}
-		file_circuitbreaker_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDefaultLimitRequest); i {
+		file_circuitbreaker_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerDetailResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1366,8 +5345,8 @@ func file_circuitbreaker_proto_init() {
 				return nil
 			}
 		}
-		file_circuitbreaker_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDefaultLimitResponse); i {
+		file_circuitbreaker_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListQueuedHtlcsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1378,8 +5357,8 @@ func file_circuitbreaker_proto_init() {
 				return nil
 			}
 		}
-		file_circuitbreaker_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListLimitsRequest); i {
+		file_circuitbreaker_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerQueue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1390,8 +5369,8 @@ func file_circuitbreaker_proto_init() {
 				return nil
 			}
 		}
-		file_circuitbreaker_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListLimitsResponse); i {
+		file_circuitbreaker_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListQueuedHtlcsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1402,8 +5381,8 @@ func file_circuitbreaker_proto_init() {
 				return nil
 			}
 		}
-		file_circuitbreaker_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeLimit); i {
+		file_circuitbreaker_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveQueuedHtlcRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1414,7 +5393,139 @@ func file_circuitbreaker_proto_init() {
 				return nil
 			}
 		}
-		file_circuitbreaker_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_circuitbreaker_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveQueuedHtlcResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListQueueActionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueActionRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListQueueActionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLimitChangesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLimitChangesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LimitChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertLimitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertLimitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Limit); i {
 			case 0:
 				return &v.state
@@ -1426,7 +5537,7 @@ func file_circuitbreaker_proto_init() {
 				return nil
 			}
 		}
-		file_circuitbreaker_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_circuitbreaker_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Counter); i {
 			case 0:
 				return &v.state
@@ -1438,7 +5549,7 @@ func file_circuitbreaker_proto_init() {
 				return nil
 			}
 		}
-		file_circuitbreaker_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_circuitbreaker_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListForwardingHistoryRequest); i {
 			case 0:
 				return &v.state
@@ -1450,7 +5561,7 @@ func file_circuitbreaker_proto_init() {
 				return nil
 			}
 		}
-		file_circuitbreaker_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_circuitbreaker_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListForwardingHistoryResponse); i {
 			case 0:
 				return &v.state
@@ -1462,7 +5573,7 @@ func file_circuitbreaker_proto_init() {
 				return nil
 			}
 		}
-		file_circuitbreaker_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_circuitbreaker_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CircuitKey); i {
 			case 0:
 				return &v.state
@@ -1474,7 +5585,7 @@ func file_circuitbreaker_proto_init() {
 				return nil
 			}
 		}
-		file_circuitbreaker_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_circuitbreaker_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Forward); i {
 			case 0:
 				return &v.state
@@ -1486,14 +5597,146 @@ func file_circuitbreaker_proto_init() {
 				return nil
 			}
 		}
+		file_circuitbreaker_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportForwardingHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportedForward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetForwardingStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetForwardingStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardingStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportForwardingHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportForwardingHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeHtlcDecisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circuitbreaker_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HtlcDecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_circuitbreaker_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   18,
+			NumEnums:      10,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -41,6 +41,8 @@ func (m *GetInfoRequest) Validate() error {
 		return nil
 	}
 
+	// no validation rules for NodeKey
+
 	return nil
 }
 
@@ -114,6 +116,12 @@ func (m *GetInfoResponse) Validate() error {
 
 	// no validation rules for Version
 
+	// no validation rules for HtlcAutoFailSupported
+
+	// no validation rules for QueueModesAllowed
+
+	// no validation rules for InterceptorRequired
+
 	return nil
 }
 
@@ -171,6 +179,276 @@ var _ interface {
 	ErrorName() string
 } = GetInfoResponseValidationError{}
 
+// Validate checks the field values on GetOverviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetOverviewRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// GetOverviewRequestValidationError is the validation error returned by
+// GetOverviewRequest.Validate if the designated constraints aren't met.
+type GetOverviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOverviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOverviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOverviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOverviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOverviewRequestValidationError) ErrorName() string {
+	return "GetOverviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOverviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOverviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOverviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOverviewRequestValidationError{}
+
+// Validate checks the field values on GetOverviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetOverviewResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetNodes() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetOverviewResponseValidationError{
+					field:  fmt.Sprintf("Nodes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetCounter_1H()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetOverviewResponseValidationError{
+				field:  "Counter_1H",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetCounter_24H()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetOverviewResponseValidationError{
+				field:  "Counter_24H",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for QueueLen
+
+	// no validation rules for PendingHtlcCount
+
+	return nil
+}
+
+// GetOverviewResponseValidationError is the validation error returned by
+// GetOverviewResponse.Validate if the designated constraints aren't met.
+type GetOverviewResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOverviewResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOverviewResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOverviewResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOverviewResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOverviewResponseValidationError) ErrorName() string {
+	return "GetOverviewResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOverviewResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOverviewResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOverviewResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOverviewResponseValidationError{}
+
+// Validate checks the field values on NodeOverview with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *NodeOverview) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for NodeKey
+
+	// no validation rules for NodeAlias
+
+	// no validation rules for NodeVersion
+
+	// no validation rules for PeerCount
+
+	if v, ok := interface{}(m.GetCounter_1H()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NodeOverviewValidationError{
+				field:  "Counter_1H",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetCounter_24H()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NodeOverviewValidationError{
+				field:  "Counter_24H",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for QueueLen
+
+	// no validation rules for PendingHtlcCount
+
+	return nil
+}
+
+// NodeOverviewValidationError is the validation error returned by
+// NodeOverview.Validate if the designated constraints aren't met.
+type NodeOverviewValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NodeOverviewValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NodeOverviewValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NodeOverviewValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NodeOverviewValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NodeOverviewValidationError) ErrorName() string { return "NodeOverviewValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NodeOverviewValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNodeOverview.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NodeOverviewValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NodeOverviewValidationError{}
+
 // Validate checks the field values on ClearLimitsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -179,6 +457,8 @@ func (m *ClearLimitsRequest) Validate() error {
 		return nil
 	}
 
+	// no validation rules for NodeKey
+
 	return nil
 }
 
@@ -330,6 +610,8 @@ func (m *UpdateLimitsRequest) Validate() error {
 
 	}
 
+	// no validation rules for NodeKey
+
 	return nil
 }
 
@@ -474,6 +756,8 @@ func (m *UpdateDefaultLimitRequest) Validate() error {
 		}
 	}
 
+	// no validation rules for NodeKey
+
 	return nil
 }
 
@@ -608,6 +892,8 @@ func (m *ListLimitsRequest) Validate() error {
 		return nil
 	}
 
+	// no validation rules for NodeKey
+
 	return nil
 }
 
@@ -861,25 +1147,24 @@ var _ interface {
 	ErrorName() string
 } = NodeLimitValidationError{}
 
-// Validate checks the field values on Limit with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Limit) Validate() error {
+// Validate checks the field values on GetPeerDetailRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetPeerDetailRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for MaxHourlyRate
-
-	// no validation rules for MaxPending
+	// no validation rules for NodeKey
 
-	// no validation rules for Mode
+	// no validation rules for Node
 
 	return nil
 }
 
-// LimitValidationError is the validation error returned by Limit.Validate if
-// the designated constraints aren't met.
-type LimitValidationError struct {
+// GetPeerDetailRequestValidationError is the validation error returned by
+// GetPeerDetailRequest.Validate if the designated constraints aren't met.
+type GetPeerDetailRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -887,22 +1172,24 @@ type LimitValidationError struct {
 }
 
 // Field function returns field value.
-func (e LimitValidationError) Field() string { return e.field }
+func (e GetPeerDetailRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LimitValidationError) Reason() string { return e.reason }
+func (e GetPeerDetailRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LimitValidationError) Cause() error { return e.cause }
+func (e GetPeerDetailRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LimitValidationError) Key() bool { return e.key }
+func (e GetPeerDetailRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LimitValidationError) ErrorName() string { return "LimitValidationError" }
+func (e GetPeerDetailRequestValidationError) ErrorName() string {
+	return "GetPeerDetailRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e LimitValidationError) Error() string {
+func (e GetPeerDetailRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -914,14 +1201,14 @@ func (e LimitValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLimit.%s: %s%s",
+		"invalid %sGetPeerDetailRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LimitValidationError{}
+var _ error = GetPeerDetailRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -929,27 +1216,2490 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LimitValidationError{}
+} = GetPeerDetailRequestValidationError{}
 
-// Validate checks the field values on Counter with the rules defined in the
+// Validate checks the field values on PeerChannel with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *PeerChannel) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ShortChannelId
+
+	// no validation rules for Initiator
+
+	// no validation rules for State
+
+	return nil
+}
+
+// PeerChannelValidationError is the validation error returned by
+// PeerChannel.Validate if the designated constraints aren't met.
+type PeerChannelValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeerChannelValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeerChannelValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeerChannelValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeerChannelValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeerChannelValidationError) ErrorName() string { return "PeerChannelValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PeerChannelValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeerChannel.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeerChannelValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeerChannelValidationError{}
+
+// Validate checks the field values on PeerHtlc with the rules defined in the
 // proto definition for this message. If any rules are violated, an error is returned.
-func (m *Counter) Validate() error {
+func (m *PeerHtlc) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetIncomingCircuit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PeerHtlcValidationError{
+				field:  "IncomingCircuit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OutgoingChannel
+
+	// no validation rules for IncomingAmount
+
+	// no validation rules for OutgoingAmount
+
+	// no validation rules for SinceNs
+
+	// no validation rules for AgeNs
+
+	return nil
+}
+
+// PeerHtlcValidationError is the validation error returned by
+// PeerHtlc.Validate if the designated constraints aren't met.
+type PeerHtlcValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeerHtlcValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeerHtlcValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeerHtlcValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeerHtlcValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeerHtlcValidationError) ErrorName() string { return "PeerHtlcValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PeerHtlcValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeerHtlc.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeerHtlcValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeerHtlcValidationError{}
+
+// Validate checks the field values on PeerDirectionStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PeerDirectionStats) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for SettledCount
+
+	// no validation rules for FailedCount
+
+	// no validation rules for SettleRatio
+
+	// no validation rules for FeesMsat
+
+	// no validation rules for HoldTimeP50Ns
+
+	// no validation rules for HoldTimeP90Ns
+
+	// no validation rules for HoldTimeP99Ns
+
+	return nil
+}
+
+// PeerDirectionStatsValidationError is the validation error returned by
+// PeerDirectionStats.Validate if the designated constraints aren't met.
+type PeerDirectionStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeerDirectionStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeerDirectionStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeerDirectionStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeerDirectionStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeerDirectionStatsValidationError) ErrorName() string {
+	return "PeerDirectionStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PeerDirectionStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeerDirectionStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeerDirectionStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeerDirectionStatsValidationError{}
+
+// Validate checks the field values on PeerStats with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *PeerStats) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for WindowSecs
+
+	if v, ok := interface{}(m.GetIncoming()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PeerStatsValidationError{
+				field:  "Incoming",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetOutgoing()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PeerStatsValidationError{
+				field:  "Outgoing",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// PeerStatsValidationError is the validation error returned by
+// PeerStats.Validate if the designated constraints aren't met.
+type PeerStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeerStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeerStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeerStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeerStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeerStatsValidationError) ErrorName() string { return "PeerStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PeerStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeerStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeerStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeerStatsValidationError{}
+
+// Validate checks the field values on GetPeerDetailResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetPeerDetailResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Node
+
+	// no validation rules for Alias
+
+	for idx, item := range m.GetChannels() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPeerDetailResponseValidationError{
+					field:  fmt.Sprintf("Channels[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPeerDetailResponseValidationError{
+				field:  "Limit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LimitSource
+
+	// no validation rules for LimiterTokens
+
+	if v, ok := interface{}(m.GetCounter_1H()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPeerDetailResponseValidationError{
+				field:  "Counter_1H",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetCounter_24H()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPeerDetailResponseValidationError{
+				field:  "Counter_24H",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetPendingHtlcs() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPeerDetailResponseValidationError{
+					field:  fmt.Sprintf("PendingHtlcs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetQueuedHtlcs() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPeerDetailResponseValidationError{
+					field:  fmt.Sprintf("QueuedHtlcs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetStats() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPeerDetailResponseValidationError{
+					field:  fmt.Sprintf("Stats[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetPeerDetailResponseValidationError is the validation error returned by
+// GetPeerDetailResponse.Validate if the designated constraints aren't met.
+type GetPeerDetailResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPeerDetailResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPeerDetailResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPeerDetailResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPeerDetailResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPeerDetailResponseValidationError) ErrorName() string {
+	return "GetPeerDetailResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPeerDetailResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPeerDetailResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPeerDetailResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPeerDetailResponseValidationError{}
+
+// Validate checks the field values on ListQueuedHtlcsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListQueuedHtlcsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for NodeKey
+
+	// no validation rules for Node
+
+	return nil
+}
+
+// ListQueuedHtlcsRequestValidationError is the validation error returned by
+// ListQueuedHtlcsRequest.Validate if the designated constraints aren't met.
+type ListQueuedHtlcsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQueuedHtlcsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQueuedHtlcsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQueuedHtlcsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQueuedHtlcsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQueuedHtlcsRequestValidationError) ErrorName() string {
+	return "ListQueuedHtlcsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQueuedHtlcsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQueuedHtlcsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQueuedHtlcsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQueuedHtlcsRequestValidationError{}
+
+// Validate checks the field values on PeerQueue with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *PeerQueue) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Node
+
+	// no validation rules for Alias
+
+	for idx, item := range m.GetHtlcs() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PeerQueueValidationError{
+					field:  fmt.Sprintf("Htlcs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// PeerQueueValidationError is the validation error returned by
+// PeerQueue.Validate if the designated constraints aren't met.
+type PeerQueueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeerQueueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeerQueueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeerQueueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeerQueueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeerQueueValidationError) ErrorName() string { return "PeerQueueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PeerQueueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeerQueue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeerQueueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeerQueueValidationError{}
+
+// Validate checks the field values on ListQueuedHtlcsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListQueuedHtlcsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetQueues() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListQueuedHtlcsResponseValidationError{
+					field:  fmt.Sprintf("Queues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListQueuedHtlcsResponseValidationError is the validation error returned by
+// ListQueuedHtlcsResponse.Validate if the designated constraints aren't met.
+type ListQueuedHtlcsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQueuedHtlcsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQueuedHtlcsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQueuedHtlcsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQueuedHtlcsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQueuedHtlcsResponseValidationError) ErrorName() string {
+	return "ListQueuedHtlcsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQueuedHtlcsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQueuedHtlcsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQueuedHtlcsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQueuedHtlcsResponseValidationError{}
+
+// Validate checks the field values on ResolveQueuedHtlcRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ResolveQueuedHtlcRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for NodeKey
+
+	if v, ok := interface{}(m.GetIncomingCircuit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResolveQueuedHtlcRequestValidationError{
+				field:  "IncomingCircuit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Action
+
+	return nil
+}
+
+// ResolveQueuedHtlcRequestValidationError is the validation error returned by
+// ResolveQueuedHtlcRequest.Validate if the designated constraints aren't met.
+type ResolveQueuedHtlcRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveQueuedHtlcRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveQueuedHtlcRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveQueuedHtlcRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveQueuedHtlcRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveQueuedHtlcRequestValidationError) ErrorName() string {
+	return "ResolveQueuedHtlcRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveQueuedHtlcRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveQueuedHtlcRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveQueuedHtlcRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveQueuedHtlcRequestValidationError{}
+
+// Validate checks the field values on ResolveQueuedHtlcResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ResolveQueuedHtlcResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// ResolveQueuedHtlcResponseValidationError is the validation error returned by
+// ResolveQueuedHtlcResponse.Validate if the designated constraints aren't met.
+type ResolveQueuedHtlcResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveQueuedHtlcResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveQueuedHtlcResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveQueuedHtlcResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveQueuedHtlcResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveQueuedHtlcResponseValidationError) ErrorName() string {
+	return "ResolveQueuedHtlcResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveQueuedHtlcResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveQueuedHtlcResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveQueuedHtlcResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveQueuedHtlcResponseValidationError{}
+
+// Validate checks the field values on FlushQueueRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *FlushQueueRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for NodeKey
+
+	// no validation rules for Node
+
+	// no validation rules for Action
+
+	return nil
+}
+
+// FlushQueueRequestValidationError is the validation error returned by
+// FlushQueueRequest.Validate if the designated constraints aren't met.
+type FlushQueueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlushQueueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlushQueueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlushQueueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlushQueueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlushQueueRequestValidationError) ErrorName() string {
+	return "FlushQueueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FlushQueueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlushQueueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlushQueueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlushQueueRequestValidationError{}
+
+// Validate checks the field values on FlushQueueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *FlushQueueResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Resolved
+
+	return nil
+}
+
+// FlushQueueResponseValidationError is the validation error returned by
+// FlushQueueResponse.Validate if the designated constraints aren't met.
+type FlushQueueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlushQueueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlushQueueResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlushQueueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlushQueueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlushQueueResponseValidationError) ErrorName() string {
+	return "FlushQueueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FlushQueueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlushQueueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlushQueueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlushQueueResponseValidationError{}
+
+// Validate checks the field values on ListQueueActionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListQueueActionsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for NodeKey
+
+	// no validation rules for Node
+
+	// no validation rules for Limit
+
+	return nil
+}
+
+// ListQueueActionsRequestValidationError is the validation error returned by
+// ListQueueActionsRequest.Validate if the designated constraints aren't met.
+type ListQueueActionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQueueActionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQueueActionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQueueActionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQueueActionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQueueActionsRequestValidationError) ErrorName() string {
+	return "ListQueueActionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQueueActionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQueueActionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQueueActionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQueueActionsRequestValidationError{}
+
+// Validate checks the field values on QueueActionRecord with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *QueueActionRecord) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for TimestampNs
+
+	// no validation rules for Node
+
+	// no validation rules for Action
+
+	if v, ok := interface{}(m.GetHtlc()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueueActionRecordValidationError{
+				field:  "Htlc",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Caller
+
+	return nil
+}
+
+// QueueActionRecordValidationError is the validation error returned by
+// QueueActionRecord.Validate if the designated constraints aren't met.
+type QueueActionRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueueActionRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueueActionRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueueActionRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueueActionRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueueActionRecordValidationError) ErrorName() string {
+	return "QueueActionRecordValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueueActionRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueueActionRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueueActionRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueueActionRecordValidationError{}
+
+// Validate checks the field values on ListQueueActionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListQueueActionsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetActions() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListQueueActionsResponseValidationError{
+					field:  fmt.Sprintf("Actions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListQueueActionsResponseValidationError is the validation error returned by
+// ListQueueActionsResponse.Validate if the designated constraints aren't met.
+type ListQueueActionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQueueActionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQueueActionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQueueActionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQueueActionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQueueActionsResponseValidationError) ErrorName() string {
+	return "ListQueueActionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQueueActionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQueueActionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQueueActionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQueueActionsResponseValidationError{}
+
+// Validate checks the field values on ListLimitChangesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListLimitChangesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for NodeKey
+
+	// no validation rules for Node
+
+	// no validation rules for DefaultLimit
+
+	// no validation rules for Limit
+
+	return nil
+}
+
+// ListLimitChangesRequestValidationError is the validation error returned by
+// ListLimitChangesRequest.Validate if the designated constraints aren't met.
+type ListLimitChangesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLimitChangesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLimitChangesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLimitChangesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLimitChangesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLimitChangesRequestValidationError) ErrorName() string {
+	return "ListLimitChangesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLimitChangesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLimitChangesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLimitChangesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLimitChangesRequestValidationError{}
+
+// Validate checks the field values on ListLimitChangesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListLimitChangesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLimitChangesResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListLimitChangesResponseValidationError is the validation error returned by
+// ListLimitChangesResponse.Validate if the designated constraints aren't met.
+type ListLimitChangesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLimitChangesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLimitChangesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLimitChangesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLimitChangesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLimitChangesResponseValidationError) ErrorName() string {
+	return "ListLimitChangesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLimitChangesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLimitChangesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLimitChangesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLimitChangesResponseValidationError{}
+
+// Validate checks the field values on LimitChange with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *LimitChange) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for TimestampNs
+
+	// no validation rules for Node
+
+	if v, ok := interface{}(m.GetOldLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LimitChangeValidationError{
+				field:  "OldLimit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetNewLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LimitChangeValidationError{
+				field:  "NewLimit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Caller
+
+	// no validation rules for RevertedChangeId
+
+	return nil
+}
+
+// LimitChangeValidationError is the validation error returned by
+// LimitChange.Validate if the designated constraints aren't met.
+type LimitChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LimitChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LimitChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LimitChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LimitChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LimitChangeValidationError) ErrorName() string { return "LimitChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LimitChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLimitChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LimitChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LimitChangeValidationError{}
+
+// Validate checks the field values on RevertLimitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RevertLimitRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for NodeKey
+
+	// no validation rules for Node
+
+	return nil
+}
+
+// RevertLimitRequestValidationError is the validation error returned by
+// RevertLimitRequest.Validate if the designated constraints aren't met.
+type RevertLimitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevertLimitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevertLimitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevertLimitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevertLimitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevertLimitRequestValidationError) ErrorName() string {
+	return "RevertLimitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevertLimitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevertLimitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevertLimitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevertLimitRequestValidationError{}
+
+// Validate checks the field values on RevertLimitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RevertLimitResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for RevertedChangeId
+
+	return nil
+}
+
+// RevertLimitResponseValidationError is the validation error returned by
+// RevertLimitResponse.Validate if the designated constraints aren't met.
+type RevertLimitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevertLimitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevertLimitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevertLimitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevertLimitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevertLimitResponseValidationError) ErrorName() string {
+	return "RevertLimitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevertLimitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevertLimitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevertLimitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevertLimitResponseValidationError{}
+
+// Validate checks the field values on Limit with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Limit) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for MaxHourlyRate
+
+	// no validation rules for MaxPending
+
+	// no validation rules for Mode
+
+	return nil
+}
+
+// LimitValidationError is the validation error returned by Limit.Validate if
+// the designated constraints aren't met.
+type LimitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LimitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LimitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LimitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LimitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LimitValidationError) ErrorName() string { return "LimitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LimitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLimit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LimitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LimitValidationError{}
+
+// Validate checks the field values on Counter with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Counter) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Fail
+
+	// no validation rules for Success
+
+	// no validation rules for Reject
+
+	return nil
+}
+
+// CounterValidationError is the validation error returned by Counter.Validate
+// if the designated constraints aren't met.
+type CounterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CounterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CounterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CounterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CounterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CounterValidationError) ErrorName() string { return "CounterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CounterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCounter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CounterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CounterValidationError{}
+
+// Validate checks the field values on ListForwardingHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListForwardingHistoryRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for AddStartTimeNs
+
+	// no validation rules for AddEndTimeNs
+
+	// no validation rules for NodeKey
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	// no validation rules for After
+
+	// no validation rules for IncomingPeer
+
+	// no validation rules for OutgoingPeer
+
+	// no validation rules for Channel
+
+	// no validation rules for Settled
+
+	// no validation rules for SortOrder
+
+	return nil
+}
+
+// ListForwardingHistoryRequestValidationError is the validation error returned
+// by ListForwardingHistoryRequest.Validate if the designated constraints
+// aren't met.
+type ListForwardingHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListForwardingHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListForwardingHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListForwardingHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListForwardingHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListForwardingHistoryRequestValidationError) ErrorName() string {
+	return "ListForwardingHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListForwardingHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListForwardingHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListForwardingHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListForwardingHistoryRequestValidationError{}
+
+// Validate checks the field values on ListForwardingHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListForwardingHistoryResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetForwards() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListForwardingHistoryResponseValidationError{
+					field:  fmt.Sprintf("Forwards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	return nil
+}
+
+// ListForwardingHistoryResponseValidationError is the validation error
+// returned by ListForwardingHistoryResponse.Validate if the designated
+// constraints aren't met.
+type ListForwardingHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListForwardingHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListForwardingHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListForwardingHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListForwardingHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListForwardingHistoryResponseValidationError) ErrorName() string {
+	return "ListForwardingHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListForwardingHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListForwardingHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListForwardingHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListForwardingHistoryResponseValidationError{}
+
+// Validate checks the field values on CircuitKey with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *CircuitKey) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ShortChannelId
+
+	// no validation rules for HtlcIndex
+
+	return nil
+}
+
+// CircuitKeyValidationError is the validation error returned by
+// CircuitKey.Validate if the designated constraints aren't met.
+type CircuitKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CircuitKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CircuitKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CircuitKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CircuitKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CircuitKeyValidationError) ErrorName() string { return "CircuitKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CircuitKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCircuitKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CircuitKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CircuitKeyValidationError{}
+
+// Validate checks the field values on Forward with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Forward) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for AddTimeNs
+
+	// no validation rules for ResolveTimeNs
+
+	// no validation rules for Settled
+
+	// no validation rules for IncomingAmount
+
+	// no validation rules for OutgoingAmount
+
+	// no validation rules for IncomingPeer
+
+	if v, ok := interface{}(m.GetIncomingCircuit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ForwardValidationError{
+				field:  "IncomingCircuit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OutgoingPeer
+
+	if v, ok := interface{}(m.GetOutgoingCircuit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ForwardValidationError{
+				field:  "OutgoingCircuit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Source
+
+	return nil
+}
+
+// ForwardValidationError is the validation error returned by Forward.Validate
+// if the designated constraints aren't met.
+type ForwardValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForwardValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForwardValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForwardValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForwardValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForwardValidationError) ErrorName() string { return "ForwardValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ForwardValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForward.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForwardValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForwardValidationError{}
+
+// Validate checks the field values on ExportForwardingHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExportForwardingHistoryRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for NodeKey
+
+	// no validation rules for AddStartTimeNs
+
+	// no validation rules for AddEndTimeNs
+
+	return nil
+}
+
+// ExportForwardingHistoryRequestValidationError is the validation error
+// returned by ExportForwardingHistoryRequest.Validate if the designated
+// constraints aren't met.
+type ExportForwardingHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportForwardingHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportForwardingHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportForwardingHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportForwardingHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportForwardingHistoryRequestValidationError) ErrorName() string {
+	return "ExportForwardingHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportForwardingHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportForwardingHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportForwardingHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportForwardingHistoryRequestValidationError{}
+
+// Validate checks the field values on ExportedForward with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ExportedForward) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetForward()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportedForwardValidationError{
+				field:  "Forward",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FeeMsat
+
+	// no validation rules for HoldTimeNs
+
+	// no validation rules for IncomingPeerAlias
+
+	// no validation rules for OutgoingPeerAlias
+
+	return nil
+}
+
+// ExportedForwardValidationError is the validation error returned by
+// ExportedForward.Validate if the designated constraints aren't met.
+type ExportedForwardValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportedForwardValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportedForwardValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportedForwardValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportedForwardValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportedForwardValidationError) ErrorName() string { return "ExportedForwardValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportedForwardValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportedForward.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportedForwardValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportedForwardValidationError{}
+
+// Validate checks the field values on GetForwardingStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetForwardingStatsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for NodeKey
+
+	// no validation rules for Granularity
+
+	// no validation rules for StartTimeNs
+
+	// no validation rules for EndTimeNs
+
+	// no validation rules for IncomingPeer
+
+	// no validation rules for OutgoingPeer
+
+	return nil
+}
+
+// GetForwardingStatsRequestValidationError is the validation error returned by
+// GetForwardingStatsRequest.Validate if the designated constraints aren't met.
+type GetForwardingStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetForwardingStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetForwardingStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetForwardingStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetForwardingStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetForwardingStatsRequestValidationError) ErrorName() string {
+	return "GetForwardingStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetForwardingStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetForwardingStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetForwardingStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetForwardingStatsRequestValidationError{}
+
+// Validate checks the field values on GetForwardingStatsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetForwardingStatsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetStats() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetForwardingStatsResponseValidationError{
+					field:  fmt.Sprintf("Stats[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetForwardingStatsResponseValidationError is the validation error returned
+// by GetForwardingStatsResponse.Validate if the designated constraints aren't met.
+type GetForwardingStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetForwardingStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetForwardingStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetForwardingStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetForwardingStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetForwardingStatsResponseValidationError) ErrorName() string {
+	return "GetForwardingStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetForwardingStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetForwardingStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetForwardingStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetForwardingStatsResponseValidationError{}
+
+// Validate checks the field values on ForwardingStats with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ForwardingStats) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Fail
+	// no validation rules for BucketStartNs
 
-	// no validation rules for Success
+	// no validation rules for IncomingPeer
 
-	// no validation rules for Reject
+	// no validation rules for OutgoingPeer
+
+	// no validation rules for SettledCount
+
+	// no validation rules for FailedCount
+
+	// no validation rules for SettledAmountMsat
+
+	// no validation rules for FeesMsat
+
+	// no validation rules for HoldTimeNs
+
+	// no validation rules for HoldTimeCount
+
+	// no validation rules for AvgHoldTimeNs
 
 	return nil
 }
 
-// CounterValidationError is the validation error returned by Counter.Validate
-// if the designated constraints aren't met.
-type CounterValidationError struct {
+// ForwardingStatsValidationError is the validation error returned by
+// ForwardingStats.Validate if the designated constraints aren't met.
+type ForwardingStatsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -957,22 +3707,22 @@ type CounterValidationError struct {
 }
 
 // Field function returns field value.
-func (e CounterValidationError) Field() string { return e.field }
+func (e ForwardingStatsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CounterValidationError) Reason() string { return e.reason }
+func (e ForwardingStatsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CounterValidationError) Cause() error { return e.cause }
+func (e ForwardingStatsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CounterValidationError) Key() bool { return e.key }
+func (e ForwardingStatsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CounterValidationError) ErrorName() string { return "CounterValidationError" }
+func (e ForwardingStatsValidationError) ErrorName() string { return "ForwardingStatsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CounterValidationError) Error() string {
+func (e ForwardingStatsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -984,14 +3734,14 @@ func (e CounterValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCounter.%s: %s%s",
+		"invalid %sForwardingStats.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CounterValidationError{}
+var _ error = ForwardingStatsValidationError{}
 
 var _ interface {
 	Field() string
@@ -999,27 +3749,29 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CounterValidationError{}
+} = ForwardingStatsValidationError{}
 
-// Validate checks the field values on ListForwardingHistoryRequest with the
+// Validate checks the field values on ImportForwardingHistoryRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ListForwardingHistoryRequest) Validate() error {
+func (m *ImportForwardingHistoryRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for AddStartTimeNs
+	// no validation rules for NodeKey
 
-	// no validation rules for AddEndTimeNs
+	// no validation rules for StartTimeNs
+
+	// no validation rules for EndTimeNs
 
 	return nil
 }
 
-// ListForwardingHistoryRequestValidationError is the validation error returned
-// by ListForwardingHistoryRequest.Validate if the designated constraints
-// aren't met.
-type ListForwardingHistoryRequestValidationError struct {
+// ImportForwardingHistoryRequestValidationError is the validation error
+// returned by ImportForwardingHistoryRequest.Validate if the designated
+// constraints aren't met.
+type ImportForwardingHistoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1027,24 +3779,24 @@ type ListForwardingHistoryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListForwardingHistoryRequestValidationError) Field() string { return e.field }
+func (e ImportForwardingHistoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListForwardingHistoryRequestValidationError) Reason() string { return e.reason }
+func (e ImportForwardingHistoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListForwardingHistoryRequestValidationError) Cause() error { return e.cause }
+func (e ImportForwardingHistoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListForwardingHistoryRequestValidationError) Key() bool { return e.key }
+func (e ImportForwardingHistoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListForwardingHistoryRequestValidationError) ErrorName() string {
-	return "ListForwardingHistoryRequestValidationError"
+func (e ImportForwardingHistoryRequestValidationError) ErrorName() string {
+	return "ImportForwardingHistoryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListForwardingHistoryRequestValidationError) Error() string {
+func (e ImportForwardingHistoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1056,14 +3808,14 @@ func (e ListForwardingHistoryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListForwardingHistoryRequest.%s: %s%s",
+		"invalid %sImportForwardingHistoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListForwardingHistoryRequestValidationError{}
+var _ error = ImportForwardingHistoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1071,38 +3823,27 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListForwardingHistoryRequestValidationError{}
+} = ImportForwardingHistoryRequestValidationError{}
 
-// Validate checks the field values on ListForwardingHistoryResponse with the
+// Validate checks the field values on ImportForwardingHistoryResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ListForwardingHistoryResponse) Validate() error {
+func (m *ImportForwardingHistoryResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetForwards() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListForwardingHistoryResponseValidationError{
-					field:  fmt.Sprintf("Forwards[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Imported
 
-	}
+	// no validation rules for Skipped
 
 	return nil
 }
 
-// ListForwardingHistoryResponseValidationError is the validation error
-// returned by ListForwardingHistoryResponse.Validate if the designated
+// ImportForwardingHistoryResponseValidationError is the validation error
+// returned by ImportForwardingHistoryResponse.Validate if the designated
 // constraints aren't met.
-type ListForwardingHistoryResponseValidationError struct {
+type ImportForwardingHistoryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1110,24 +3851,24 @@ type ListForwardingHistoryResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListForwardingHistoryResponseValidationError) Field() string { return e.field }
+func (e ImportForwardingHistoryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListForwardingHistoryResponseValidationError) Reason() string { return e.reason }
+func (e ImportForwardingHistoryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListForwardingHistoryResponseValidationError) Cause() error { return e.cause }
+func (e ImportForwardingHistoryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListForwardingHistoryResponseValidationError) Key() bool { return e.key }
+func (e ImportForwardingHistoryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListForwardingHistoryResponseValidationError) ErrorName() string {
-	return "ListForwardingHistoryResponseValidationError"
+func (e ImportForwardingHistoryResponseValidationError) ErrorName() string {
+	return "ImportForwardingHistoryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListForwardingHistoryResponseValidationError) Error() string {
+func (e ImportForwardingHistoryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1139,14 +3880,14 @@ func (e ListForwardingHistoryResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListForwardingHistoryResponse.%s: %s%s",
+		"invalid %sImportForwardingHistoryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListForwardingHistoryResponseValidationError{}
+var _ error = ImportForwardingHistoryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1154,25 +3895,89 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListForwardingHistoryResponseValidationError{}
+} = ImportForwardingHistoryResponseValidationError{}
 
-// Validate checks the field values on CircuitKey with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *CircuitKey) Validate() error {
+// Validate checks the field values on BackupRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *BackupRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for ShortChannelId
+	return nil
+}
 
-	// no validation rules for HtlcIndex
+// BackupRequestValidationError is the validation error returned by
+// BackupRequest.Validate if the designated constraints aren't met.
+type BackupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BackupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BackupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BackupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BackupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BackupRequestValidationError) ErrorName() string { return "BackupRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BackupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBackupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BackupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BackupRequestValidationError{}
+
+// Validate checks the field values on BackupChunk with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *BackupChunk) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Data
 
 	return nil
 }
 
-// CircuitKeyValidationError is the validation error returned by
-// CircuitKey.Validate if the designated constraints aren't met.
-type CircuitKeyValidationError struct {
+// BackupChunkValidationError is the validation error returned by
+// BackupChunk.Validate if the designated constraints aren't met.
+type BackupChunkValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1180,22 +3985,22 @@ type CircuitKeyValidationError struct {
 }
 
 // Field function returns field value.
-func (e CircuitKeyValidationError) Field() string { return e.field }
+func (e BackupChunkValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CircuitKeyValidationError) Reason() string { return e.reason }
+func (e BackupChunkValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CircuitKeyValidationError) Cause() error { return e.cause }
+func (e BackupChunkValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CircuitKeyValidationError) Key() bool { return e.key }
+func (e BackupChunkValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CircuitKeyValidationError) ErrorName() string { return "CircuitKeyValidationError" }
+func (e BackupChunkValidationError) ErrorName() string { return "BackupChunkValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CircuitKeyValidationError) Error() string {
+func (e BackupChunkValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1207,14 +4012,14 @@ func (e CircuitKeyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCircuitKey.%s: %s%s",
+		"invalid %sBackupChunk.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CircuitKeyValidationError{}
+var _ error = BackupChunkValidationError{}
 
 var _ interface {
 	Field() string
@@ -1222,30 +4027,97 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CircuitKeyValidationError{}
+} = BackupChunkValidationError{}
 
-// Validate checks the field values on Forward with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Forward) Validate() error {
+// Validate checks the field values on SubscribeHtlcDecisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SubscribeHtlcDecisionsRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for AddTimeNs
+	// no validation rules for NodeKey
 
-	// no validation rules for ResolveTimeNs
+	return nil
+}
 
-	// no validation rules for Settled
+// SubscribeHtlcDecisionsRequestValidationError is the validation error
+// returned by SubscribeHtlcDecisionsRequest.Validate if the designated
+// constraints aren't met.
+type SubscribeHtlcDecisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	// no validation rules for IncomingAmount
+// Field function returns field value.
+func (e SubscribeHtlcDecisionsRequestValidationError) Field() string { return e.field }
 
-	// no validation rules for OutgoingAmount
+// Reason function returns reason value.
+func (e SubscribeHtlcDecisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubscribeHtlcDecisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubscribeHtlcDecisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubscribeHtlcDecisionsRequestValidationError) ErrorName() string {
+	return "SubscribeHtlcDecisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubscribeHtlcDecisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubscribeHtlcDecisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubscribeHtlcDecisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubscribeHtlcDecisionsRequestValidationError{}
+
+// Validate checks the field values on HtlcDecision with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *HtlcDecision) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for TimeNs
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
 
 	// no validation rules for IncomingPeer
 
 	if v, ok := interface{}(m.GetIncomingCircuit()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ForwardValidationError{
+			return HtlcDecisionValidationError{
 				field:  "IncomingCircuit",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1257,7 +4129,7 @@ func (m *Forward) Validate() error {
 
 	if v, ok := interface{}(m.GetOutgoingCircuit()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ForwardValidationError{
+			return HtlcDecisionValidationError{
 				field:  "OutgoingCircuit",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1265,12 +4137,26 @@ func (m *Forward) Validate() error {
 		}
 	}
 
+	// no validation rules for IncomingAmount
+
+	// no validation rules for OutgoingAmount
+
+	// no validation rules for QueuePosition
+
+	// no validation rules for Dequeued
+
+	// no validation rules for Operator
+
+	// no validation rules for Settled
+
+	// no validation rules for Missed
+
 	return nil
 }
 
-// ForwardValidationError is the validation error returned by Forward.Validate
-// if the designated constraints aren't met.
-type ForwardValidationError struct {
+// HtlcDecisionValidationError is the validation error returned by
+// HtlcDecision.Validate if the designated constraints aren't met.
+type HtlcDecisionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1278,22 +4164,22 @@ type ForwardValidationError struct {
 }
 
 // Field function returns field value.
-func (e ForwardValidationError) Field() string { return e.field }
+func (e HtlcDecisionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ForwardValidationError) Reason() string { return e.reason }
+func (e HtlcDecisionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ForwardValidationError) Cause() error { return e.cause }
+func (e HtlcDecisionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ForwardValidationError) Key() bool { return e.key }
+func (e HtlcDecisionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ForwardValidationError) ErrorName() string { return "ForwardValidationError" }
+func (e HtlcDecisionValidationError) ErrorName() string { return "HtlcDecisionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ForwardValidationError) Error() string {
+func (e HtlcDecisionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1305,14 +4191,14 @@ func (e ForwardValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sForward.%s: %s%s",
+		"invalid %sHtlcDecision.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ForwardValidationError{}
+var _ error = HtlcDecisionValidationError{}
 
 var _ interface {
 	Field() string
@@ -1320,4 +4206,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ForwardValidationError{}
+} = HtlcDecisionValidationError{}
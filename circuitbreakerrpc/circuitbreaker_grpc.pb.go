@@ -18,13 +18,56 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ServiceClient interface {
+	// Return a summary of all connected lnd nodes and their aggregated
+	// htlc counters.
+	GetOverview(ctx context.Context, in *GetOverviewRequest, opts ...grpc.CallOption) (*GetOverviewResponse, error)
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
 	UpdateLimits(ctx context.Context, in *UpdateLimitsRequest, opts ...grpc.CallOption) (*UpdateLimitsResponse, error)
 	// Clear specific limits and use default.
 	ClearLimits(ctx context.Context, in *ClearLimitsRequest, opts ...grpc.CallOption) (*ClearLimitsResponse, error)
 	UpdateDefaultLimit(ctx context.Context, in *UpdateDefaultLimitRequest, opts ...grpc.CallOption) (*UpdateDefaultLimitResponse, error)
 	ListLimits(ctx context.Context, in *ListLimitsRequest, opts ...grpc.CallOption) (*ListLimitsResponse, error)
+	// Return the details of a single peer: its channels, the htlcs that are
+	// pending and queued, the state of its limiter and statistics computed
+	// from the forwarding history.
+	GetPeerDetail(ctx context.Context, in *GetPeerDetailRequest, opts ...grpc.CallOption) (*GetPeerDetailResponse, error)
+	// List the htlcs that are held in the queues of the peers, starting with
+	// the next htlc to be forwarded.
+	ListQueuedHtlcs(ctx context.Context, in *ListQueuedHtlcsRequest, opts ...grpc.CallOption) (*ListQueuedHtlcsResponse, error)
+	// Forward or fail a single queued htlc. Forwarding bypasses the limits
+	// of the peer.
+	ResolveQueuedHtlc(ctx context.Context, in *ResolveQueuedHtlcRequest, opts ...grpc.CallOption) (*ResolveQueuedHtlcResponse, error)
+	// Forward or fail all queued htlcs of a peer.
+	FlushQueue(ctx context.Context, in *FlushQueueRequest, opts ...grpc.CallOption) (*FlushQueueResponse, error)
+	// List the recorded operator actions on queued htlcs, most recent first.
+	ListQueueActions(ctx context.Context, in *ListQueueActionsRequest, opts ...grpc.CallOption) (*ListQueueActionsResponse, error)
+	// List the recorded changes to the limits, most recent first.
+	ListLimitChanges(ctx context.Context, in *ListLimitChangesRequest, opts ...grpc.CallOption) (*ListLimitChangesResponse, error)
+	// Restore the limit of a peer, or the default limit, to the value that
+	// it had before its most recent change. Reverts and changes that were
+	// reverted already are skipped, so that repeated calls step back through
+	// the history of the limit.
+	RevertLimit(ctx context.Context, in *RevertLimitRequest, opts ...grpc.CallOption) (*RevertLimitResponse, error)
 	ListForwardingHistory(ctx context.Context, in *ListForwardingHistoryRequest, opts ...grpc.CallOption) (*ListForwardingHistoryResponse, error)
+	// Stream the forwarding history, including derived fields. Also
+	// available as csv or json lines via http on /api/export.
+	ExportForwardingHistory(ctx context.Context, in *ExportForwardingHistoryRequest, opts ...grpc.CallOption) (Service_ExportForwardingHistoryClient, error)
+	// Return forwarding statistics aggregated per time bucket and pair of
+	// incoming and outgoing peer. Statistics are retained much longer than
+	// the forwarding history.
+	GetForwardingStats(ctx context.Context, in *GetForwardingStatsRequest, opts ...grpc.CallOption) (*GetForwardingStatsResponse, error)
+	// Backfill the forwarding history with the settled forwards that lnd
+	// keeps in its forwarding log. Forwards that are already present are
+	// skipped.
+	ImportForwardingHistory(ctx context.Context, in *ImportForwardingHistoryRequest, opts ...grpc.CallOption) (*ImportForwardingHistoryResponse, error)
+	// Stream a consistent snapshot of the sqlite database. It can be taken
+	// while circuitbreaker is running and restored with `circuitbreaker
+	// restore`. Not supported with the postgres backend.
+	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (Service_BackupClient, error)
+	// Stream every decision on an intercepted htlc and every resolution in
+	// real time. Also available as server-sent events via http on
+	// /api/htlc_decisions.
+	SubscribeHtlcDecisions(ctx context.Context, in *SubscribeHtlcDecisionsRequest, opts ...grpc.CallOption) (Service_SubscribeHtlcDecisionsClient, error)
 }
 
 type serviceClient struct {
@@ -35,6 +78,15 @@ func NewServiceClient(cc grpc.ClientConnInterface) ServiceClient {
 	return &serviceClient{cc}
 }
 
+func (c *serviceClient) GetOverview(ctx context.Context, in *GetOverviewRequest, opts ...grpc.CallOption) (*GetOverviewResponse, error) {
+	out := new(GetOverviewResponse)
+	err := c.cc.Invoke(ctx, "/circuitbreaker.Service/GetOverview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
 	out := new(GetInfoResponse)
 	err := c.cc.Invoke(ctx, "/circuitbreaker.Service/GetInfo", in, out, opts...)
@@ -80,6 +132,69 @@ func (c *serviceClient) ListLimits(ctx context.Context, in *ListLimitsRequest, o
 	return out, nil
 }
 
+func (c *serviceClient) GetPeerDetail(ctx context.Context, in *GetPeerDetailRequest, opts ...grpc.CallOption) (*GetPeerDetailResponse, error) {
+	out := new(GetPeerDetailResponse)
+	err := c.cc.Invoke(ctx, "/circuitbreaker.Service/GetPeerDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListQueuedHtlcs(ctx context.Context, in *ListQueuedHtlcsRequest, opts ...grpc.CallOption) (*ListQueuedHtlcsResponse, error) {
+	out := new(ListQueuedHtlcsResponse)
+	err := c.cc.Invoke(ctx, "/circuitbreaker.Service/ListQueuedHtlcs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ResolveQueuedHtlc(ctx context.Context, in *ResolveQueuedHtlcRequest, opts ...grpc.CallOption) (*ResolveQueuedHtlcResponse, error) {
+	out := new(ResolveQueuedHtlcResponse)
+	err := c.cc.Invoke(ctx, "/circuitbreaker.Service/ResolveQueuedHtlc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) FlushQueue(ctx context.Context, in *FlushQueueRequest, opts ...grpc.CallOption) (*FlushQueueResponse, error) {
+	out := new(FlushQueueResponse)
+	err := c.cc.Invoke(ctx, "/circuitbreaker.Service/FlushQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListQueueActions(ctx context.Context, in *ListQueueActionsRequest, opts ...grpc.CallOption) (*ListQueueActionsResponse, error) {
+	out := new(ListQueueActionsResponse)
+	err := c.cc.Invoke(ctx, "/circuitbreaker.Service/ListQueueActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListLimitChanges(ctx context.Context, in *ListLimitChangesRequest, opts ...grpc.CallOption) (*ListLimitChangesResponse, error) {
+	out := new(ListLimitChangesResponse)
+	err := c.cc.Invoke(ctx, "/circuitbreaker.Service/ListLimitChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RevertLimit(ctx context.Context, in *RevertLimitRequest, opts ...grpc.CallOption) (*RevertLimitResponse, error) {
+	out := new(RevertLimitResponse)
+	err := c.cc.Invoke(ctx, "/circuitbreaker.Service/RevertLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) ListForwardingHistory(ctx context.Context, in *ListForwardingHistoryRequest, opts ...grpc.CallOption) (*ListForwardingHistoryResponse, error) {
 	out := new(ListForwardingHistoryResponse)
 	err := c.cc.Invoke(ctx, "/circuitbreaker.Service/ListForwardingHistory", in, out, opts...)
@@ -89,17 +204,174 @@ func (c *serviceClient) ListForwardingHistory(ctx context.Context, in *ListForwa
 	return out, nil
 }
 
+func (c *serviceClient) ExportForwardingHistory(ctx context.Context, in *ExportForwardingHistoryRequest, opts ...grpc.CallOption) (Service_ExportForwardingHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Service_ServiceDesc.Streams[0], "/circuitbreaker.Service/ExportForwardingHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceExportForwardingHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_ExportForwardingHistoryClient interface {
+	Recv() (*ExportedForward, error)
+	grpc.ClientStream
+}
+
+type serviceExportForwardingHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceExportForwardingHistoryClient) Recv() (*ExportedForward, error) {
+	m := new(ExportedForward)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serviceClient) GetForwardingStats(ctx context.Context, in *GetForwardingStatsRequest, opts ...grpc.CallOption) (*GetForwardingStatsResponse, error) {
+	out := new(GetForwardingStatsResponse)
+	err := c.cc.Invoke(ctx, "/circuitbreaker.Service/GetForwardingStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ImportForwardingHistory(ctx context.Context, in *ImportForwardingHistoryRequest, opts ...grpc.CallOption) (*ImportForwardingHistoryResponse, error) {
+	out := new(ImportForwardingHistoryResponse)
+	err := c.cc.Invoke(ctx, "/circuitbreaker.Service/ImportForwardingHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (Service_BackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Service_ServiceDesc.Streams[1], "/circuitbreaker.Service/Backup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_BackupClient interface {
+	Recv() (*BackupChunk, error)
+	grpc.ClientStream
+}
+
+type serviceBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceBackupClient) Recv() (*BackupChunk, error) {
+	m := new(BackupChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serviceClient) SubscribeHtlcDecisions(ctx context.Context, in *SubscribeHtlcDecisionsRequest, opts ...grpc.CallOption) (Service_SubscribeHtlcDecisionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Service_ServiceDesc.Streams[2], "/circuitbreaker.Service/SubscribeHtlcDecisions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceSubscribeHtlcDecisionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_SubscribeHtlcDecisionsClient interface {
+	Recv() (*HtlcDecision, error)
+	grpc.ClientStream
+}
+
+type serviceSubscribeHtlcDecisionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceSubscribeHtlcDecisionsClient) Recv() (*HtlcDecision, error) {
+	m := new(HtlcDecision)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
 type ServiceServer interface {
+	// Return a summary of all connected lnd nodes and their aggregated
+	// htlc counters.
+	GetOverview(context.Context, *GetOverviewRequest) (*GetOverviewResponse, error)
 	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
 	UpdateLimits(context.Context, *UpdateLimitsRequest) (*UpdateLimitsResponse, error)
 	// Clear specific limits and use default.
 	ClearLimits(context.Context, *ClearLimitsRequest) (*ClearLimitsResponse, error)
 	UpdateDefaultLimit(context.Context, *UpdateDefaultLimitRequest) (*UpdateDefaultLimitResponse, error)
 	ListLimits(context.Context, *ListLimitsRequest) (*ListLimitsResponse, error)
+	// Return the details of a single peer: its channels, the htlcs that are
+	// pending and queued, the state of its limiter and statistics computed
+	// from the forwarding history.
+	GetPeerDetail(context.Context, *GetPeerDetailRequest) (*GetPeerDetailResponse, error)
+	// List the htlcs that are held in the queues of the peers, starting with
+	// the next htlc to be forwarded.
+	ListQueuedHtlcs(context.Context, *ListQueuedHtlcsRequest) (*ListQueuedHtlcsResponse, error)
+	// Forward or fail a single queued htlc. Forwarding bypasses the limits
+	// of the peer.
+	ResolveQueuedHtlc(context.Context, *ResolveQueuedHtlcRequest) (*ResolveQueuedHtlcResponse, error)
+	// Forward or fail all queued htlcs of a peer.
+	FlushQueue(context.Context, *FlushQueueRequest) (*FlushQueueResponse, error)
+	// List the recorded operator actions on queued htlcs, most recent first.
+	ListQueueActions(context.Context, *ListQueueActionsRequest) (*ListQueueActionsResponse, error)
+	// List the recorded changes to the limits, most recent first.
+	ListLimitChanges(context.Context, *ListLimitChangesRequest) (*ListLimitChangesResponse, error)
+	// Restore the limit of a peer, or the default limit, to the value that
+	// it had before its most recent change. Reverts and changes that were
+	// reverted already are skipped, so that repeated calls step back through
+	// the history of the limit.
+	RevertLimit(context.Context, *RevertLimitRequest) (*RevertLimitResponse, error)
 	ListForwardingHistory(context.Context, *ListForwardingHistoryRequest) (*ListForwardingHistoryResponse, error)
+	// Stream the forwarding history, including derived fields. Also
+	// available as csv or json lines via http on /api/export.
+	ExportForwardingHistory(*ExportForwardingHistoryRequest, Service_ExportForwardingHistoryServer) error
+	// Return forwarding statistics aggregated per time bucket and pair of
+	// incoming and outgoing peer. Statistics are retained much longer than
+	// the forwarding history.
+	GetForwardingStats(context.Context, *GetForwardingStatsRequest) (*GetForwardingStatsResponse, error)
+	// Backfill the forwarding history with the settled forwards that lnd
+	// keeps in its forwarding log. Forwards that are already present are
+	// skipped.
+	ImportForwardingHistory(context.Context, *ImportForwardingHistoryRequest) (*ImportForwardingHistoryResponse, error)
+	// Stream a consistent snapshot of the sqlite database. It can be taken
+	// while circuitbreaker is running and restored with `circuitbreaker
+	// restore`. Not supported with the postgres backend.
+	Backup(*BackupRequest, Service_BackupServer) error
+	// Stream every decision on an intercepted htlc and every resolution in
+	// real time. Also available as server-sent events via http on
+	// /api/htlc_decisions.
+	SubscribeHtlcDecisions(*SubscribeHtlcDecisionsRequest, Service_SubscribeHtlcDecisionsServer) error
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -107,6 +379,9 @@ type ServiceServer interface {
 type UnimplementedServiceServer struct {
 }
 
+func (UnimplementedServiceServer) GetOverview(context.Context, *GetOverviewRequest) (*GetOverviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOverview not implemented")
+}
 func (UnimplementedServiceServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
 }
@@ -122,9 +397,45 @@ func (UnimplementedServiceServer) UpdateDefaultLimit(context.Context, *UpdateDef
 func (UnimplementedServiceServer) ListLimits(context.Context, *ListLimitsRequest) (*ListLimitsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLimits not implemented")
 }
+func (UnimplementedServiceServer) GetPeerDetail(context.Context, *GetPeerDetailRequest) (*GetPeerDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerDetail not implemented")
+}
+func (UnimplementedServiceServer) ListQueuedHtlcs(context.Context, *ListQueuedHtlcsRequest) (*ListQueuedHtlcsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueuedHtlcs not implemented")
+}
+func (UnimplementedServiceServer) ResolveQueuedHtlc(context.Context, *ResolveQueuedHtlcRequest) (*ResolveQueuedHtlcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveQueuedHtlc not implemented")
+}
+func (UnimplementedServiceServer) FlushQueue(context.Context, *FlushQueueRequest) (*FlushQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushQueue not implemented")
+}
+func (UnimplementedServiceServer) ListQueueActions(context.Context, *ListQueueActionsRequest) (*ListQueueActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueueActions not implemented")
+}
+func (UnimplementedServiceServer) ListLimitChanges(context.Context, *ListLimitChangesRequest) (*ListLimitChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLimitChanges not implemented")
+}
+func (UnimplementedServiceServer) RevertLimit(context.Context, *RevertLimitRequest) (*RevertLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertLimit not implemented")
+}
 func (UnimplementedServiceServer) ListForwardingHistory(context.Context, *ListForwardingHistoryRequest) (*ListForwardingHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListForwardingHistory not implemented")
 }
+func (UnimplementedServiceServer) ExportForwardingHistory(*ExportForwardingHistoryRequest, Service_ExportForwardingHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportForwardingHistory not implemented")
+}
+func (UnimplementedServiceServer) GetForwardingStats(context.Context, *GetForwardingStatsRequest) (*GetForwardingStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForwardingStats not implemented")
+}
+func (UnimplementedServiceServer) ImportForwardingHistory(context.Context, *ImportForwardingHistoryRequest) (*ImportForwardingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportForwardingHistory not implemented")
+}
+func (UnimplementedServiceServer) Backup(*BackupRequest, Service_BackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+func (UnimplementedServiceServer) SubscribeHtlcDecisions(*SubscribeHtlcDecisionsRequest, Service_SubscribeHtlcDecisionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeHtlcDecisions not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -138,6 +449,24 @@ func RegisterServiceServer(s grpc.ServiceRegistrar, srv ServiceServer) {
 	s.RegisterService(&Service_ServiceDesc, srv)
 }
 
+func _Service_GetOverview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOverviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetOverview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circuitbreaker.Service/GetOverview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetOverview(ctx, req.(*GetOverviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetInfoRequest)
 	if err := dec(in); err != nil {
@@ -228,6 +557,132 @@ func _Service_ListLimits_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_GetPeerDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetPeerDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circuitbreaker.Service/GetPeerDetail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetPeerDetail(ctx, req.(*GetPeerDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListQueuedHtlcs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueuedHtlcsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListQueuedHtlcs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circuitbreaker.Service/ListQueuedHtlcs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListQueuedHtlcs(ctx, req.(*ListQueuedHtlcsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ResolveQueuedHtlc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveQueuedHtlcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ResolveQueuedHtlc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circuitbreaker.Service/ResolveQueuedHtlc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ResolveQueuedHtlc(ctx, req.(*ResolveQueuedHtlcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_FlushQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).FlushQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circuitbreaker.Service/FlushQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).FlushQueue(ctx, req.(*FlushQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListQueueActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueueActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListQueueActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circuitbreaker.Service/ListQueueActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListQueueActions(ctx, req.(*ListQueueActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListLimitChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLimitChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListLimitChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circuitbreaker.Service/ListLimitChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListLimitChanges(ctx, req.(*ListLimitChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RevertLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RevertLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circuitbreaker.Service/RevertLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RevertLimit(ctx, req.(*RevertLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_ListForwardingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListForwardingHistoryRequest)
 	if err := dec(in); err != nil {
@@ -246,6 +701,105 @@ func _Service_ListForwardingHistory_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_ExportForwardingHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportForwardingHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).ExportForwardingHistory(m, &serviceExportForwardingHistoryServer{stream})
+}
+
+type Service_ExportForwardingHistoryServer interface {
+	Send(*ExportedForward) error
+	grpc.ServerStream
+}
+
+type serviceExportForwardingHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceExportForwardingHistoryServer) Send(m *ExportedForward) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Service_GetForwardingStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForwardingStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetForwardingStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circuitbreaker.Service/GetForwardingStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetForwardingStats(ctx, req.(*GetForwardingStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ImportForwardingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportForwardingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ImportForwardingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circuitbreaker.Service/ImportForwardingHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ImportForwardingHistory(ctx, req.(*ImportForwardingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Backup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).Backup(m, &serviceBackupServer{stream})
+}
+
+type Service_BackupServer interface {
+	Send(*BackupChunk) error
+	grpc.ServerStream
+}
+
+type serviceBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceBackupServer) Send(m *BackupChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Service_SubscribeHtlcDecisions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeHtlcDecisionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).SubscribeHtlcDecisions(m, &serviceSubscribeHtlcDecisionsServer{stream})
+}
+
+type Service_SubscribeHtlcDecisionsServer interface {
+	Send(*HtlcDecision) error
+	grpc.ServerStream
+}
+
+type serviceSubscribeHtlcDecisionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceSubscribeHtlcDecisionsServer) Send(m *HtlcDecision) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -253,6 +807,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "circuitbreaker.Service",
 	HandlerType: (*ServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOverview",
+			Handler:    _Service_GetOverview_Handler,
+		},
 		{
 			MethodName: "GetInfo",
 			Handler:    _Service_GetInfo_Handler,
@@ -273,11 +831,63 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListLimits",
 			Handler:    _Service_ListLimits_Handler,
 		},
+		{
+			MethodName: "GetPeerDetail",
+			Handler:    _Service_GetPeerDetail_Handler,
+		},
+		{
+			MethodName: "ListQueuedHtlcs",
+			Handler:    _Service_ListQueuedHtlcs_Handler,
+		},
+		{
+			MethodName: "ResolveQueuedHtlc",
+			Handler:    _Service_ResolveQueuedHtlc_Handler,
+		},
+		{
+			MethodName: "FlushQueue",
+			Handler:    _Service_FlushQueue_Handler,
+		},
+		{
+			MethodName: "ListQueueActions",
+			Handler:    _Service_ListQueueActions_Handler,
+		},
+		{
+			MethodName: "ListLimitChanges",
+			Handler:    _Service_ListLimitChanges_Handler,
+		},
+		{
+			MethodName: "RevertLimit",
+			Handler:    _Service_RevertLimit_Handler,
+		},
 		{
 			MethodName: "ListForwardingHistory",
 			Handler:    _Service_ListForwardingHistory_Handler,
 		},
+		{
+			MethodName: "GetForwardingStats",
+			Handler:    _Service_GetForwardingStats_Handler,
+		},
+		{
+			MethodName: "ImportForwardingHistory",
+			Handler:    _Service_ImportForwardingHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportForwardingHistory",
+			Handler:       _Service_ExportForwardingHistory_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Backup",
+			Handler:       _Service_Backup_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeHtlcDecisions",
+			Handler:       _Service_SubscribeHtlcDecisions_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "circuitbreaker.proto",
 }
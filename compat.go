@@ -0,0 +1,120 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var errUnsafeQueueMode = errors.New("queue modes are unsafe with the " +
+	"connected lnd version")
+
+// lndVersion is the semantic version of an lnd node, without pre-release
+// suffix.
+type lndVersion struct {
+	major, minor, patch uint64
+}
+
+func (v lndVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.major, v.minor, v.patch)
+}
+
+func (v lndVersion) atLeast(other lndVersion) bool {
+	if v.major != other.major {
+		return v.major > other.major
+	}
+
+	if v.minor != other.minor {
+		return v.minor > other.minor
+	}
+
+	return v.patch >= other.patch
+}
+
+// parseLndVersion parses the version string that lnd reports in GetInfo, for
+// example "0.16.0-beta commit=v0.16.0-beta".
+func parseLndVersion(version string) (lndVersion, error) {
+	fields := strings.Fields(version)
+	if len(fields) == 0 {
+		return lndVersion{}, errors.New("empty version")
+	}
+
+	versionStr := strings.TrimPrefix(fields[0], "v")
+
+	// Strip the pre-release suffix, such as -beta or -beta.rc1.
+	if idx := strings.IndexByte(versionStr, '-'); idx >= 0 {
+		versionStr = versionStr[:idx]
+	}
+
+	parts := strings.Split(versionStr, ".")
+	if len(parts) != 3 {
+		return lndVersion{}, fmt.Errorf("invalid version: %v", version)
+	}
+
+	var numbers [3]uint64
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return lndVersion{}, fmt.Errorf("invalid version: %v",
+				version)
+		}
+
+		numbers[i] = n
+	}
+
+	return lndVersion{
+		major: numbers[0],
+		minor: numbers[1],
+		patch: numbers[2],
+	}, nil
+}
+
+type lndFeature int
+
+const (
+	// featureHtlcAutoFail is lnd's built-in protection that fails back
+	// htlcs that are held by the interceptor for too long. Without it,
+	// queueing htlcs can lead to force-closes.
+	featureHtlcAutoFail lndFeature = iota
+)
+
+// lndFeatureVersions is the compatibility table that lists the minimum lnd
+// version for every version dependent feature.
+var lndFeatureVersions = map[lndFeature]lndVersion{
+	featureHtlcAutoFail: {major: 0, minor: 16, patch: 0},
+}
+
+// lndCompatibility is the result of checking the version of the connected lnd
+// node against the compatibility table.
+type lndCompatibility struct {
+	version string
+
+	// features contains the version dependent features that are
+	// supported.
+	features map[lndFeature]bool
+}
+
+func newLndCompatibility(version string) (*lndCompatibility, error) {
+	compat := &lndCompatibility{
+		version:  version,
+		features: make(map[lndFeature]bool),
+	}
+
+	parsed, err := parseLndVersion(version)
+	if err != nil {
+		// Return the compatibility with all features unsupported, so that
+		// the caller can choose to continue.
+		return compat, err
+	}
+
+	for feature, minVersion := range lndFeatureVersions {
+		compat.features[feature] = parsed.atLeast(minVersion)
+	}
+
+	return compat, nil
+}
+
+func (c *lndCompatibility) supports(feature lndFeature) bool {
+	return c.features[feature]
+}
@@ -1,5 +1,7 @@
 package main
 
+import "fmt"
+
 type Mode int
 
 const (
@@ -27,3 +29,23 @@ func (m Mode) String() string {
 		panic("unknown mode")
 	}
 }
+
+// parseMode parses the string representation of a mode.
+func parseMode(str string) (Mode, error) {
+	switch str {
+	case "FAIL":
+		return ModeFail, nil
+
+	case "QUEUE":
+		return ModeQueue, nil
+
+	case "QUEUE_PEER_INITIATED":
+		return ModeQueuePeerInitiated, nil
+
+	case "BLOCK":
+		return ModeBlock, nil
+
+	default:
+		return 0, fmt.Errorf("unknown mode %v", str)
+	}
+}
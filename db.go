@@ -3,9 +3,12 @@ package main
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -74,9 +77,135 @@ var migrations = &migrate.MemoryMigrationSource{
 				`CREATE INDEX add_time_index ON forwarding_history (add_time);`,
 			},
 		},
+		{
+			Id: "4",
+			Up: []string{
+				`
+				ALTER TABLE limits RENAME TO limits_old;
+
+				CREATE TABLE IF NOT EXISTS limits (
+					node TEXT NOT NULL DEFAULT '',
+					peer TEXT NOT NULL,
+					htlc_max_pending INTEGER NOT NULL,
+					htlc_max_hourly_rate INTEGER NOT NULL,
+					mode TEXT CHECK(mode IN ('FAIL', 'QUEUE', 'QUEUE_PEER_INITIATED', 'BLOCK')) NOT NULL DEFAULT 'FAIL',
+
+					PRIMARY KEY (node, peer)
+				);
+
+				INSERT INTO limits(peer, htlc_max_pending, htlc_max_hourly_rate, mode)
+					SELECT peer, htlc_max_pending, htlc_max_hourly_rate, mode FROM limits_old;
+
+				DROP TABLE limits_old;
+				`,
+				`
+				ALTER TABLE forwarding_history RENAME TO forwarding_history_old;
+
+				DROP INDEX add_time_index;
+
+				CREATE TABLE IF NOT EXISTS forwarding_history (
+					node TEXT NOT NULL DEFAULT '',
+					add_time TIMESTAMP NOT NULL,
+					resolved_time TIMESTAMP NOT NULL,
+					settled BOOLEAN NOT NULL,
+					incoming_amt_msat INTEGER NOT NULL CHECK (incoming_amt_msat > 0),
+					outgoing_amt_msat INTEGER NOT NULL CHECK (outgoing_amt_msat > 0),
+					incoming_peer TEXT NOT NULL,
+					incoming_channel INTEGER NOT NULL,
+					incoming_htlc_index INTEGER NOT NULL,
+					outgoing_peer TEXT NOT NULL,
+					outgoing_channel INTEGER NOT NULL,
+					outgoing_htlc_index INTEGER NOT NULL,
+
+					CONSTRAINT unique_incoming_circuit UNIQUE (node, incoming_channel, incoming_htlc_index),
+					CONSTRAINT unique_outgoing_circuit UNIQUE (node, outgoing_channel, outgoing_htlc_index)
+				);
+
+				INSERT INTO forwarding_history (
+					add_time, resolved_time, settled, incoming_amt_msat,
+					outgoing_amt_msat, incoming_peer, incoming_channel,
+					incoming_htlc_index, outgoing_peer, outgoing_channel,
+					outgoing_htlc_index)
+				SELECT
+					add_time, resolved_time, settled, incoming_amt_msat,
+					outgoing_amt_msat, incoming_peer, incoming_channel,
+					incoming_htlc_index, outgoing_peer, outgoing_channel,
+					outgoing_htlc_index
+				FROM forwarding_history_old;
+
+				DROP TABLE forwarding_history_old;
+
+				CREATE INDEX add_time_index ON forwarding_history (add_time);
+				CREATE INDEX node_add_time_index ON forwarding_history (node, add_time);
+				`,
+			},
+		},
+		{
+			Id: "5",
+			Up: []string{
+				rollupTableSchema(granularityHour.table()),
+				rollupTableSchema(granularityDay.table()),
+			},
+		},
+		{
+			Id: "6",
+			Up: fwdHistoryIndexMigration,
+		},
+		{
+			Id: "7",
+			Up: fwdHistorySourceMigration,
+		},
+		{
+			Id: "8",
+			Up: limitChangesTableSchema(
+				"INTEGER PRIMARY KEY AUTOINCREMENT",
+			),
+		},
+		{
+			Id: "9",
+			Up: queueActionsTableSchema(
+				"INTEGER PRIMARY KEY AUTOINCREMENT",
+			),
+		},
+		{
+			Id: "10",
+			Up: healthCheckTableSchema,
+		},
+		{
+			Id: "11",
+			Up: processStateTableSchema,
+		},
+		{
+			Id: "12",
+			Up: historyWatermarksTableSchema,
+		},
+		{
+			Id: "13",
+			Up: limitChangesRevertMigration,
+		},
+		{
+			Id: "14",
+			Up: rollupHoldTimeCountMigration,
+		},
 	},
 }
 
+// fwdHistoryIndexMigration adds the indexes for filtering and paginating the
+// forwarding history. The channel filter is covered by the unique circuit
+// constraints.
+var fwdHistoryIndexMigration = []string{
+	`DROP INDEX node_add_time_index;`,
+	`CREATE INDEX node_add_time_index ON forwarding_history (node, add_time, incoming_channel, incoming_htlc_index);`,
+	`CREATE INDEX incoming_peer_index ON forwarding_history (node, incoming_peer, add_time);`,
+	`CREATE INDEX outgoing_peer_index ON forwarding_history (node, outgoing_peer, add_time);`,
+}
+
+// fwdHistorySourceMigration adds the column that records where a forward
+// originates from. All existing rows were recorded live.
+var fwdHistorySourceMigration = []string{
+	`ALTER TABLE forwarding_history ADD COLUMN source TEXT NOT NULL DEFAULT 'live' CHECK(source IN ('live', 'lnd'));`,
+}
+
 const (
 	// defaultFwdHistoryLimit is the default limit we place on the forwarding_history table
 	// to prevent creation of an ever-growing table.
@@ -86,16 +215,117 @@ const (
 	// * Help ourselves to 14MB of disk space
 	// -> 100_000 entries = 13 MB, plus ~0.8MB for add_time_index.
 	defaultFwdHistoryLimit = 100_000
+
+	// pruneInterval is the interval at which history tables are pruned.
+	// Between runs, the forwarding history can temporarily exceed the row
+	// limit.
+	pruneInterval = 10 * time.Minute
 )
 
 var defaultNodeKey = route.Vertex{}
 
+// initialDefaultLimit is the default limit for nodes that are new to the
+// database.
+var initialDefaultLimit = Limit{
+	MaxPending:    5,
+	MaxHourlyRate: 3600,
+	Mode:          ModeFail,
+}
+
+// Store persists limits and forwarding history.
+type Store interface {
+	// InitNode prepares the store for the lnd node provided.
+	InitNode(ctx context.Context, node route.Vertex, claimLegacy bool) error
+
+	// UpdateLimits applies a batch of limit updates to a node in a single
+	// transaction. Either all updates are applied or none. The changes are
+	// recorded along with the caller that made them.
+	UpdateLimits(ctx context.Context, node route.Vertex,
+		updates []LimitUpdate, caller string) error
+
+	// ListLimitChanges returns the recorded limit changes of a node.
+	ListLimitChanges(ctx context.Context, node route.Vertex,
+		query *LimitChangesQuery) ([]*LimitChange, error)
+
+	// RevertLimit restores the limit of a peer to what it was before its
+	// most recent change that hasn't been reverted yet.
+	RevertLimit(ctx context.Context, node, peer route.Vertex,
+		caller string, check func(*Limit) error) (*LimitUpdate, error)
+
+	// RecordQueueActions adds operator actions on queued htlcs to the
+	// history.
+	RecordQueueActions(ctx context.Context, node route.Vertex,
+		actions []*QueueActionRecord) error
+
+	// ListQueueActions returns the recorded queue actions of a node.
+	ListQueueActions(ctx context.Context, node route.Vertex,
+		query *QueueActionsQuery) ([]*QueueActionRecord, error)
+
+	// GetLimits returns the default and per-peer limits of a node.
+	GetLimits(ctx context.Context, node route.Vertex) (*Limits, error)
+
+	// RecordHtlcResolution adds a resolved htlc to the forwarding history.
+	RecordHtlcResolution(ctx context.Context, node route.Vertex,
+		htlc *HtlcInfo) error
+
+	// ListForwardingHistory returns the htlcs of a node that match the
+	// query.
+	ListForwardingHistory(ctx context.Context, node route.Vertex,
+		query *ForwardingHistoryQuery) ([]*HtlcInfo, error)
+
+	// ImportForwardingHistory adds htlcs that were obtained from another
+	// source than the interceptor to the forwarding history. The number of
+	// imported htlcs is returned.
+	ImportForwardingHistory(ctx context.Context, node route.Vertex,
+		htlcs []*HtlcInfo) (int, error)
+
+	// ListForwardingStats returns aggregated forwarding history of a node.
+	ListForwardingStats(ctx context.Context, node route.Vertex,
+		query *ForwardingStatsQuery) ([]*ForwardingStats, error)
+
+	// GetPeerStats computes the forwarding statistics of a peer over
+	// windows that end at the time provided.
+	GetPeerStats(ctx context.Context, node, peer route.Vertex,
+		windows []time.Duration, now time.Time) ([]*peerWindowStats,
+		error)
+
+	// Backup writes a consistent snapshot of the store to a new file.
+	Backup(ctx context.Context, path string) error
+
+	// CheckWritable verifies that the store accepts writes.
+	CheckWritable(ctx context.Context) error
+
+	// SaveProcessState replaces the saved state of a node.
+	SaveProcessState(ctx context.Context, node route.Vertex,
+		state *ProcessState) error
+
+	// TakeProcessState returns the saved state of a node and removes it.
+	TakeProcessState(ctx context.Context, node route.Vertex) (
+		*ProcessState, error)
+
+	Close() error
+}
+
+// dbBackend identifies the sql database that is used for storage.
+type dbBackend string
+
+const (
+	backendSqlite   dbBackend = "sqlite"
+	backendPostgres dbBackend = "postgres"
+)
+
+// Db is the sql implementation of Store. The queries are shared between the
+// supported backends, each of which has its own migration set.
 type Db struct {
-	db *sql.DB
+	db      *sql.DB
+	backend dbBackend
 
 	fwdHistoryLimit int
 }
 
+// A compile time check to ensure that Db fully implements Store.
+var _ Store = (*Db)(nil)
+
 func NewDb(ctx context.Context, dbPath string, fwdHistoryLimit int) (*Db, error) {
 	const busyTimeoutMs = 5000
 
@@ -106,8 +336,19 @@ func NewDb(ctx context.Context, dbPath string, fwdHistoryLimit int) (*Db, error)
 		return nil, err
 	}
 
-	n, err := migrate.Exec(db, "sqlite3", migrations, migrate.Up)
+	return newDb(
+		ctx, db, backendSqlite, "sqlite3", migrations, fwdHistoryLimit,
+	)
+}
+
+func newDb(ctx context.Context, db *sql.DB, backend dbBackend,
+	dialect string, migrationSource migrate.MigrationSource,
+	fwdHistoryLimit int) (*Db, error) {
+
+	n, err := migrate.Exec(db, dialect, migrationSource, migrate.Up)
 	if err != nil {
+		db.Close()
+
 		return nil, fmt.Errorf("migration error: %w", err)
 	}
 	if n > 0 {
@@ -116,12 +357,13 @@ func NewDb(ctx context.Context, dbPath string, fwdHistoryLimit int) (*Db, error)
 
 	database := &Db{
 		db:              db,
+		backend:         backend,
 		fwdHistoryLimit: fwdHistoryLimit,
 	}
 
 	// Perform a once-off cleanup of the records in the db to update to a potential
 	// change in limit value.
-	if err := database.limitHTLCRecords(ctx); err != nil {
+	if _, err := database.limitHTLCRecords(ctx); err != nil {
 		return nil, err
 	}
 
@@ -132,6 +374,31 @@ func (d *Db) Close() error {
 	return d.db.Close()
 }
 
+// rebind converts the ? placeholders of a query to the placeholder syntax of
+// the backend.
+func (d *Db) rebind(query string) string {
+	if d.backend != backendPostgres {
+		return query
+	}
+
+	var (
+		rebound strings.Builder
+		n       int
+	)
+	for _, c := range query {
+		if c != '?' {
+			rebound.WriteRune(c)
+
+			continue
+		}
+
+		n++
+		fmt.Fprintf(&rebound, "$%d", n)
+	}
+
+	return rebound.String()
+}
+
 type Limit struct {
 	MaxHourlyRate int64
 	MaxPending    int64
@@ -143,44 +410,154 @@ type Limits struct {
 	PerPeer map[route.Vertex]Limit
 }
 
-func (d *Db) UpdateLimit(ctx context.Context, peer route.Vertex,
-	limit Limit) error {
+// LimitUpdate sets or clears the limit of a peer. The default limit is
+// updated by passing in defaultNodeKey as the peer.
+type LimitUpdate struct {
+	Peer route.Vertex
+
+	// Limit is nil if the limit of the peer is cleared, so that the default
+	// limit applies.
+	Limit *Limit
+
+	// RevertedChange is the id of the limit change that this update
+	// reverts, or zero if it isn't a revert.
+	RevertedChange int64
+}
+
+// InitNode prepares the database for the lnd node provided. If claimLegacy is
+// set, limits and forwarding history that were stored before multiple nodes were
+// supported are assigned to this node. A default limit is created for the node
+// if it doesn't have one yet.
+func (d *Db) InitNode(ctx context.Context, node route.Vertex,
+	claimLegacy bool) error {
+
+	nodeHex := hex.EncodeToString(node[:])
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if claimLegacy {
+		const claimLimits = `UPDATE limits SET node = ? WHERE node = '' AND
+			peer NOT IN (SELECT peer FROM limits WHERE node = ?);`
 
-	peerHex := hex.EncodeToString(peer[:])
+		_, err := tx.ExecContext(
+			ctx, d.rebind(claimLimits), nodeHex, nodeHex,
+		)
+		if err != nil {
+			return err
+		}
 
-	const replace string = `REPLACE INTO limits(peer, htlc_max_pending, htlc_max_hourly_rate, mode) VALUES(?, ?, ?, ?);`
+		const claimHistory = `UPDATE forwarding_history SET node = ?
+			WHERE node = '';`
 
-	_, err := d.db.ExecContext(
-		ctx, replace, peerHex,
-		limit.MaxPending, limit.MaxHourlyRate,
-		limit.Mode.String(),
+		_, err = tx.ExecContext(ctx, d.rebind(claimHistory), nodeHex)
+		if err != nil {
+			return err
+		}
+	}
+
+	const insertDefault = `INSERT INTO limits(node, peer, htlc_max_pending, htlc_max_hourly_rate, mode)
+		VALUES(?, ?, ?, ?, ?)
+		ON CONFLICT (node, peer) DO NOTHING;`
+
+	_, err = tx.ExecContext(
+		ctx, d.rebind(insertDefault), nodeHex,
+		hex.EncodeToString(defaultNodeKey[:]),
+		initialDefaultLimit.MaxPending, initialDefaultLimit.MaxHourlyRate,
+		initialDefaultLimit.Mode.String(),
 	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (d *Db) UpdateLimits(ctx context.Context, node route.Vertex,
+	updates []LimitUpdate, caller string) error {
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	for _, update := range updates {
+		err := d.updateLimit(ctx, tx, node, update, caller)
+		if err != nil {
+			return fmt.Errorf("cannot update limit of %v: %w",
+				update.Peer, err)
+		}
+	}
 
-	return err
+	return tx.Commit()
 }
 
-func (d *Db) ClearLimit(ctx context.Context, peer route.Vertex) error {
-	if peer == defaultNodeKey {
+// updateLimit applies a single limit update within a transaction and records
+// the change.
+func (d *Db) updateLimit(ctx context.Context, tx *sql.Tx, node route.Vertex,
+	update LimitUpdate, caller string) error {
+
+	if update.Limit == nil && update.Peer == defaultNodeKey {
 		return errors.New("cannot clear default limit")
 	}
 
-	const query string = `DELETE FROM limits WHERE peer = ?;`
+	old, err := d.getLimit(ctx, tx, node, update.Peer)
+	if err != nil {
+		return err
+	}
 
-	_, err := d.db.ExecContext(
-		ctx, query, hex.EncodeToString(peer[:]),
-	)
+	nodeHex := hex.EncodeToString(node[:])
+	peerHex := hex.EncodeToString(update.Peer[:])
+
+	if update.Limit == nil {
+		const query string = `DELETE FROM limits WHERE node = ? AND peer = ?;`
+
+		_, err = tx.ExecContext(ctx, d.rebind(query), nodeHex, peerHex)
+	} else {
+		const upsert string = `INSERT INTO limits(node, peer, htlc_max_pending, htlc_max_hourly_rate, mode) VALUES(?, ?, ?, ?, ?)
+			ON CONFLICT (node, peer) DO UPDATE SET
+				htlc_max_pending = excluded.htlc_max_pending,
+				htlc_max_hourly_rate = excluded.htlc_max_hourly_rate,
+				mode = excluded.mode;`
+
+		_, err = tx.ExecContext(
+			ctx, d.rebind(upsert), nodeHex, peerHex,
+			update.Limit.MaxPending, update.Limit.MaxHourlyRate,
+			update.Limit.Mode.String(),
+		)
+	}
+	if err != nil {
+		return err
+	}
 
-	return err
+	return d.recordLimitChange(
+		ctx, tx, node, update.Peer, old, update.Limit, caller,
+		update.RevertedChange,
+	)
 }
 
-func (d *Db) GetLimits(ctx context.Context) (*Limits, error) {
+func (d *Db) GetLimits(ctx context.Context, node route.Vertex) (*Limits,
+	error) {
+
 	const query string = `
-	SELECT peer, htlc_max_pending, htlc_max_hourly_rate, mode from limits;`
+	SELECT peer, htlc_max_pending, htlc_max_hourly_rate, mode from limits
+	WHERE node = ?;`
 
-	rows, err := d.db.QueryContext(ctx, query)
+	rows, err := d.db.QueryContext(
+		ctx, d.rebind(query), hex.EncodeToString(node[:]),
+	)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	var limits = Limits{
 		PerPeer: make(map[route.Vertex]Limit),
@@ -198,21 +575,9 @@ func (d *Db) GetLimits(ctx context.Context) (*Limits, error) {
 			return nil, err
 		}
 
-		switch modeStr {
-		case "FAIL":
-			limit.Mode = ModeFail
-
-		case "QUEUE":
-			limit.Mode = ModeQueue
-
-		case "QUEUE_PEER_INITIATED":
-			limit.Mode = ModeQueuePeerInitiated
-
-		case "BLOCK":
-			limit.Mode = ModeBlock
-
-		default:
-			return nil, errors.New("unknown mode")
+		limit.Mode, err = parseMode(modeStr)
+		if err != nil {
+			return nil, err
 		}
 
 		key, err := route.NewVertexFromStr(peerHex)
@@ -230,7 +595,22 @@ func (d *Db) GetLimits(ctx context.Context) (*Limits, error) {
 	return &limits, nil
 }
 
+// htlcSource indicates where a htlc in the forwarding history was recorded.
+type htlcSource string
+
+const (
+	// sourceLive is used for htlcs that were recorded by circuitbreaker
+	// when they resolved.
+	sourceLive htlcSource = "live"
+
+	// sourceLnd is used for htlcs that were imported from lnd's forwarding
+	// log. Lnd only logs settled htlcs and doesn't record add times and htlc
+	// indices.
+	sourceLnd htlcSource = "lnd"
+)
+
 type HtlcInfo struct {
+	source          htlcSource
 	addTime         time.Time
 	resolveTime     time.Time
 	settled         bool
@@ -242,26 +622,83 @@ type HtlcInfo struct {
 	outgoingCircuit circuitKey
 }
 
-// RecordHtlcResolution records a HTLC that has been resolved and deletes the oldest rows from
-// the forwarding history table if the total row count has exceeded the configured limit.
-func (d *Db) RecordHtlcResolution(ctx context.Context,
+// holdTime returns the time that the htlc was in flight. False is returned if
+// the add time is unknown.
+func (h *HtlcInfo) holdTime() (time.Duration, bool) {
+	// Htlcs imported from lnd have no add time.
+	if h.source == sourceLnd {
+		return 0, false
+	}
+
+	// Unknown add times are stored as the zero time, which doesn't survive
+	// the round trip through the database. Any add time before the unix
+	// epoch is therefore considered unknown too.
+	if h.addTime.IsZero() || h.addTime.UnixNano() <= 0 {
+		return 0, false
+	}
+
+	if h.resolveTime.Before(h.addTime) {
+		return 0, true
+	}
+
+	return h.resolveTime.Sub(h.addTime), true
+}
+
+// htlcIndices returns the incoming and outgoing htlc index. For htlcs imported
+// from lnd, the stored indices only serve to make the circuits unique and zero
+// is returned.
+func (h *HtlcInfo) htlcIndices() (uint64, uint64) {
+	if h.source == sourceLnd {
+		return 0, 0
+	}
+
+	return h.incomingCircuit.htlc, h.outgoingCircuit.htlc
+}
+
+// RecordHtlcResolution records a HTLC that has been resolved by the node
+// provided and adds it to the rollup tables. The forwarding history table is
+// trimmed to the configured limit by the pruner.
+func (d *Db) RecordHtlcResolution(ctx context.Context, node route.Vertex,
 	htlc *HtlcInfo) error {
 
-	// If the database is configured to not store any records, save the hassle of
-	// writing and deleting a record by returning early.
-	if d.fwdHistoryLimit == 0 {
-		return nil
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// If the database is configured to not store any records, save the
+	// hassle of writing and deleting a record. Rollups are still updated.
+	if d.fwdHistoryLimit != 0 {
+		_, err := d.insertHtlcResolution(ctx, tx, node, htlc, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := d.updateRollups(ctx, tx, node, htlc); err != nil {
+		return err
 	}
 
-	if err := d.insertHtlcResolution(ctx, htlc); err != nil {
+	err = d.recordFirstLive(ctx, tx, node, htlc.resolveTime.UnixNano())
+	if err != nil {
 		return err
 	}
 
-	return d.limitHTLCRecords(ctx)
+	return tx.Commit()
 }
 
-func (d *Db) insertHtlcResolution(ctx context.Context, htlc *HtlcInfo) error {
+// insertHtlcResolution inserts a htlc into the forwarding history. If
+// ignoreConflicts is set, a htlc that conflicts with the unique circuit
+// constraints is skipped instead of failing the insert. Returns whether the
+// htlc was inserted.
+func (d *Db) insertHtlcResolution(ctx context.Context, tx *sql.Tx,
+	node route.Vertex, htlc *HtlcInfo, ignoreConflicts bool) (bool, error) {
+
 	insert := `INSERT INTO forwarding_history (
+                node,
                 add_time,
                 resolved_time,
                 settled,
@@ -272,11 +709,17 @@ func (d *Db) insertHtlcResolution(ctx context.Context, htlc *HtlcInfo) error {
                 incoming_htlc_index,
                 outgoing_peer,
                 outgoing_channel,
-                outgoing_htlc_index)
-                VALUES (?,?,?,?,?,?,?,?,?,?,?);`
+                outgoing_htlc_index,
+                source)
+                VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?)`
+
+	if ignoreConflicts {
+		insert += " ON CONFLICT DO NOTHING"
+	}
 
-	_, err := d.db.ExecContext(
-		ctx, insert,
+	result, err := tx.ExecContext(
+		ctx, d.rebind(insert),
+		hex.EncodeToString(node[:]),
 		htlc.addTime.UnixNano(),
 		htlc.resolveTime.UnixNano(),
 		htlc.settled,
@@ -288,30 +731,110 @@ func (d *Db) insertHtlcResolution(ctx context.Context, htlc *HtlcInfo) error {
 		hex.EncodeToString(htlc.outgoingPeer[:]),
 		htlc.outgoingCircuit.channel,
 		htlc.outgoingCircuit.htlc,
+		string(htlc.source),
 	)
+	if err != nil {
+		return false, err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// ImportForwardingHistory adds htlcs to the forwarding history and the
+// rollups in a single transaction. Htlcs that conflict with the unique
+// circuit constraints are skipped, so that importing the same htlcs again has
+// no effect. Htlcs that resolved after the first live htlc of the node are
+// skipped too, because the live history already covers that period. The same
+// applies to htlcs that resolved before the latest pruned htlc, because the
+// rollups may still include them.
+func (d *Db) ImportForwardingHistory(ctx context.Context, node route.Vertex,
+	htlcs []*HtlcInfo) (int, error) {
+
+	if d.fwdHistoryLimit == 0 {
+		return 0, errors.New("forwarding history is disabled")
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	watermarks, err := d.getHistoryWatermarks(ctx, tx, node)
+	if err != nil {
+		return 0, err
+	}
+
+	var imported int
+	for _, htlc := range htlcs {
+		if watermarks.covers(htlc.resolveTime.UnixNano()) {
+			continue
+		}
+
+		inserted, err := d.insertHtlcResolution(ctx, tx, node, htlc, true)
+		if err != nil {
+			return 0, err
+		}
+		if !inserted {
+			continue
+		}
 
-	return err
+		if err := d.updateRollups(ctx, tx, node, htlc); err != nil {
+			return 0, err
+		}
+
+		imported++
+	}
+
+	return imported, tx.Commit()
 }
 
 // limitHTLCRecords counts the number of forwarding history records in the database and
 // preemptively deletes records to fall 10% below the forwarding history limit if it has
-// been reached.
+// been reached. The limit is enforced per node.
 //
 // Note that the count and deletion of records is *not* atomic, so this function may
 // not delete precisely 10% of the limit if other operations take place between count
 // and deletion.
-func (d *Db) limitHTLCRecords(ctx context.Context) error {
-	query := `SELECT COUNT(add_time) from forwarding_history`
+//
+// The number of deleted records is returned.
+func (d *Db) limitHTLCRecords(ctx context.Context) (int64, error) {
+	// The limit applies to each node separately, so that a busy node can't
+	// evict the history of the others.
+	query := `SELECT node, COUNT(add_time) FROM forwarding_history
+		GROUP BY node;`
 
-	var rowCount int
-	err := d.db.QueryRow(query).Scan(&rowCount)
+	rows, err := d.db.QueryContext(ctx, query)
 	if err != nil {
-		return err
+		return 0, err
 	}
+	defer rows.Close()
+
+	var nodes []string
+	for rows.Next() {
+		var (
+			node     string
+			rowCount int
+		)
+		if err := rows.Scan(&node, &rowCount); err != nil {
+			return 0, err
+		}
 
-	if rowCount < d.fwdHistoryLimit {
-		return nil
+		if rowCount >= d.fwdHistoryLimit {
+			nodes = append(nodes, node)
+		}
 	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	rows.Close()
 
 	// If we've hit our row count, delete oldest entries over the row limit plus an
 	// extra 10% of the limit to free up space so that we don't need to constantly
@@ -323,25 +846,300 @@ func (d *Db) limitHTLCRecords(ctx context.Context) error {
 	// be deleting all the time anyway, so this isn't a big performance hit.
 	offset := d.fwdHistoryLimit - (d.fwdHistoryLimit / 10)
 
-	query = `DELETE FROM forwarding_history
-        WHERE add_time <= (
+	where := `node = ? AND add_time <= (
                 SELECT add_time
                 FROM forwarding_history
+                WHERE node = ?
                 ORDER BY add_time DESC
                 LIMIT 1 OFFSET ?
-        );`
+        )`
+
+	var deleted int64
+	for _, node := range nodes {
+		n, err := d.deleteForwardingHistory(
+			ctx, where, node, node, offset,
+		)
+		if err != nil {
+			return 0, err
+		}
+		deleted += n
+	}
+
+	return deleted, nil
+}
+
+// historyTable is a table that grows with forwarding activity and is subject
+// to the retention policy.
+type historyTable struct {
+	name string
+
+	// timeColumn is the column holding the unix nano timestamp that
+	// determines the age of a row.
+	timeColumn string
+
+	// minRetention is the minimum period for which rows are kept,
+	// regardless of the configured retention period.
+	minRetention time.Duration
+}
+
+// forwardingHistoryTable is the table that holds the raw forwarding history.
+const forwardingHistoryTable = "forwarding_history"
+
+// historyTables lists all tables that are pruned by the retention policy.
+// Forwarding history is aged by resolve time, because the add time may be
+// unknown. Rollups are small and kept much longer than the raw history. Limit
+// changes and queue actions are audit logs and are never pruned.
+var historyTables = []historyTable{
+	{name: forwardingHistoryTable, timeColumn: "resolved_time"},
+	{
+		name:         granularityHour.table(),
+		timeColumn:   "bucket_start",
+		minRetention: 365 * 24 * time.Hour,
+	},
+	{
+		name:         granularityDay.table(),
+		timeColumn:   "bucket_start",
+		minRetention: 5 * 365 * 24 * time.Hour,
+	},
+}
+
+// pruneHistory deletes the rows of all history tables that are older than the
+// retention period. The number of deleted rows is returned per table.
+func (d *Db) pruneHistory(ctx context.Context, retention time.Duration,
+	now time.Time) (map[string]int64, error) {
+
+	pruned := make(map[string]int64)
+	for _, table := range historyTables {
+		tableRetention := retention
+		if tableRetention < table.minRetention {
+			tableRetention = table.minRetention
+		}
+		cutoff := now.Add(-tableRetention)
+		where := fmt.Sprintf(`%v < ?`, table.timeColumn)
+
+		// Pruning forwarding history also moves the watermarks.
+		if table.name == forwardingHistoryTable {
+			n, err := d.deleteForwardingHistory(
+				ctx, where, cutoff.UnixNano(),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			pruned[table.name] = n
+
+			continue
+		}
+
+		query := fmt.Sprintf(`DELETE FROM %v WHERE %v;`, table.name, where)
+
+		result, err := d.db.ExecContext(
+			ctx, d.rebind(query), cutoff.UnixNano(),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		n, err := result.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
 
-	_, err = d.db.ExecContext(ctx, query, offset)
+		pruned[table.name] = n
+	}
+
+	return pruned, nil
+}
+
+// prune enforces the retention period on all history tables and the row limit
+// on the forwarding history. A zero retention period disables time-based
+// pruning.
+func (d *Db) prune(ctx context.Context, retention time.Duration,
+	now time.Time) error {
+
+	if retention > 0 {
+		pruned, err := d.pruneHistory(ctx, retention, now)
+		if err != nil {
+			return err
+		}
+
+		for table, n := range pruned {
+			if n == 0 {
+				continue
+			}
+
+			log.Infow("Pruned history beyond retention period",
+				"table", table, "rows", n)
+		}
+	}
+
+	n, err := d.limitHTLCRecords(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n > 0 {
+		log.Infow("Pruned forwarding history beyond row limit",
+			"rows", n, "limit", d.fwdHistoryLimit)
+	}
+
+	return nil
+}
+
+// RunPruner prunes history tables at the interval provided until the context
+// is cancelled.
+func (d *Db) RunPruner(ctx context.Context, retention,
+	interval time.Duration) error {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.prune(ctx, retention, time.Now()); err != nil {
+			// A failure to prune isn't fatal, we'll try again on
+			// the next tick.
+			log.Errorw("Error pruning history", "err", err)
+		}
+
+		select {
+		case <-ticker.C:
+
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// fwdHistoryCursor identifies the position of a forward in the forwarding
+// history ordering. It is used to continue listing after that forward.
+type fwdHistoryCursor struct {
+	addTime int64
+	channel uint64
+	htlc    uint64
+}
+
+// newFwdHistoryCursor returns the cursor that points at the htlc provided.
+func newFwdHistoryCursor(htlc *HtlcInfo) *fwdHistoryCursor {
+	return &fwdHistoryCursor{
+		addTime: htlc.addTime.UnixNano(),
+		channel: htlc.incomingCircuit.channel,
+		htlc:    htlc.incomingCircuit.htlc,
+	}
+}
+
+// encode serializes the cursor into an opaque token.
+func (c *fwdHistoryCursor) encode() string {
+	return base64.RawURLEncoding.EncodeToString(
+		[]byte(fmt.Sprintf("%d:%d:%d", c.addTime, c.channel, c.htlc)),
+	)
+}
+
+func decodeFwdHistoryCursor(token string) (*fwdHistoryCursor, error) {
+	errInvalid := errors.New("invalid cursor")
 
-	return err
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errInvalid
+	}
+
+	var cursor fwdHistoryCursor
+	_, err = fmt.Sscanf(
+		string(decoded), "%d:%d:%d", &cursor.addTime, &cursor.channel,
+		&cursor.htlc,
+	)
+	if err != nil {
+		return nil, errInvalid
+	}
+
+	return &cursor, nil
 }
 
-// ListForwardingHistory returns a list of htlcs that were resolved within the
-// time range provided (start time is inclusive, end time is exclusive)
-func (d *Db) ListForwardingHistory(ctx context.Context, start, end time.Time) (
-	[]*HtlcInfo, error) {
+// ForwardingHistoryQuery selects the forwards to list. Forwards are ordered by
+// add time, with the incoming circuit as tie breaker.
+type ForwardingHistoryQuery struct {
+	// Start is the inclusive and End the exclusive bound on the add time.
+	Start, End time.Time
+
+	// IncomingPeer and OutgoingPeer optionally restrict the forwards to
+	// those peers.
+	IncomingPeer *route.Vertex
+	OutgoingPeer *route.Vertex
+
+	// Channel optionally restricts the forwards to those that came in or
+	// went out through this channel.
+	Channel uint64
+
+	// Settled optionally restricts the forwards to those that settled or
+	// failed.
+	Settled *bool
+
+	Descending bool
+
+	// Limit is the maximum number of forwards to return. Zero means no
+	// limit.
+	Limit int
+
+	// Offset is the number of forwards to skip.
+	Offset int
+
+	// After makes the listing start after the forward that the cursor
+	// points at.
+	After *fwdHistoryCursor
+}
+
+// ListForwardingHistory returns a list of htlcs that were resolved by the node
+// provided and match the query.
+func (d *Db) ListForwardingHistory(ctx context.Context, node route.Vertex,
+	query *ForwardingHistoryQuery) ([]*HtlcInfo, error) {
+
+	var (
+		conditions = []string{
+			"node = ?", "add_time >= ?", "add_time < ?",
+		}
+		args = []interface{}{
+			hex.EncodeToString(node[:]), query.Start.UnixNano(),
+			query.End.UnixNano(),
+		}
+	)
+
+	if query.IncomingPeer != nil {
+		conditions = append(conditions, "incoming_peer = ?")
+		args = append(args, hex.EncodeToString(query.IncomingPeer[:]))
+	}
+
+	if query.OutgoingPeer != nil {
+		conditions = append(conditions, "outgoing_peer = ?")
+		args = append(args, hex.EncodeToString(query.OutgoingPeer[:]))
+	}
+
+	if query.Channel != 0 {
+		conditions = append(conditions,
+			"(incoming_channel = ? OR outgoing_channel = ?)")
+		args = append(args, query.Channel, query.Channel)
+	}
 
-	list := `SELECT 
+	if query.Settled != nil {
+		conditions = append(conditions, "settled = ?")
+		args = append(args, *query.Settled)
+	}
+
+	order, comparison := "ASC", ">"
+	if query.Descending {
+		order, comparison = "DESC", "<"
+	}
+
+	if query.After != nil {
+		conditions = append(conditions, fmt.Sprintf(
+			"(add_time, incoming_channel, incoming_htlc_index) "+
+				"%v (?, ?, ?)", comparison,
+		))
+		args = append(
+			args, query.After.addTime, query.After.channel,
+			query.After.htlc,
+		)
+	}
+
+	list := fmt.Sprintf(`SELECT 
                 add_time,
                 resolved_time,
                 settled,
@@ -352,11 +1150,32 @@ func (d *Db) ListForwardingHistory(ctx context.Context, start, end time.Time) (
                 incoming_htlc_index,
                 outgoing_peer,
                 outgoing_channel,
-                outgoing_htlc_index
+                outgoing_htlc_index,
+                source
                 FROM forwarding_history
-                WHERE add_time >= ? AND add_time < ?;`
+                WHERE %[1]v
+                ORDER BY add_time %[2]v, incoming_channel %[2]v,
+                        incoming_htlc_index %[2]v`,
+		strings.Join(conditions, " AND "), order)
+
+	if query.Limit > 0 {
+		list += " LIMIT ?"
+		args = append(args, query.Limit)
+	}
+
+	if query.Offset > 0 {
+		// Sqlite doesn't support an offset without a limit, so use
+		// the largest possible limit if none is set.
+		if query.Limit <= 0 {
+			list += " LIMIT ?"
+			args = append(args, math.MaxInt64)
+		}
+
+		list += " OFFSET ?"
+		args = append(args, query.Offset)
+	}
 
-	rows, err := d.db.QueryContext(ctx, list, start.UnixNano(), end.UnixNano())
+	rows, err := d.db.QueryContext(ctx, d.rebind(list), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -382,6 +1201,7 @@ func (d *Db) ListForwardingHistory(ctx context.Context, start, end time.Time) (
 			&outgoingPeer,
 			&htlc.outgoingCircuit.channel,
 			&htlc.outgoingCircuit.htlc,
+			&htlc.source,
 		)
 		if err != nil {
 			return nil, err
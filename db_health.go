@@ -0,0 +1,26 @@
+package main
+
+import (
+	"context"
+	"time"
+)
+
+// healthCheckTableSchema is the schema of the table that the health checks
+// write to. It holds a single row.
+var healthCheckTableSchema = []string{
+	`CREATE TABLE IF NOT EXISTS health_check (
+		id INTEGER PRIMARY KEY CHECK (id = 0),
+		check_time BIGINT NOT NULL
+	);`,
+}
+
+// CheckWritable verifies that the database accepts writes by recording the time
+// of the check.
+func (d *Db) CheckWritable(ctx context.Context) error {
+	const upsert = `INSERT INTO health_check (id, check_time) VALUES (0, ?)
+		ON CONFLICT (id) DO UPDATE SET check_time = excluded.check_time;`
+
+	_, err := d.db.ExecContext(ctx, d.rebind(upsert), time.Now().UnixNano())
+
+	return err
+}
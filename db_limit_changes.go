@@ -0,0 +1,337 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// limitChangesTableSchema returns the schema of the limit changes table. The
+// id column definition differs between sqlite and postgres. The old and new
+// limit columns are null if the peer had no limit of its own.
+func limitChangesTableSchema(idColumn string) []string {
+	return []string{
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS limit_changes (
+			id %v,
+			node TEXT NOT NULL,
+			peer TEXT NOT NULL,
+			change_time BIGINT NOT NULL,
+			old_htlc_max_pending BIGINT,
+			old_htlc_max_hourly_rate BIGINT,
+			old_mode TEXT,
+			new_htlc_max_pending BIGINT,
+			new_htlc_max_hourly_rate BIGINT,
+			new_mode TEXT,
+			caller TEXT NOT NULL
+		);`, idColumn),
+		`CREATE INDEX limit_changes_peer_index ON limit_changes (node, peer, id);`,
+	}
+}
+
+// limitChangesRevertMigration adds the column that links a revert to the
+// change that it reverted.
+var limitChangesRevertMigration = []string{
+	`ALTER TABLE limit_changes ADD COLUMN reverted_change_id BIGINT;`,
+}
+
+// LimitChange is a change to the default limit or the limit of a peer.
+type LimitChange struct {
+	ID   int64
+	Time time.Time
+
+	// Peer is defaultNodeKey for changes to the default limit.
+	Peer route.Vertex
+
+	// Old and New are nil if the peer had no limit of its own before or
+	// after the change.
+	Old *Limit
+	New *Limit
+
+	// Caller identifies who made the change.
+	Caller string
+
+	// RevertedChange is the id of the change that this change reverted, or
+	// zero if it isn't a revert.
+	RevertedChange int64
+}
+
+// LimitChangesQuery selects the limit changes to return.
+type LimitChangesQuery struct {
+	// Peer optionally restricts the changes to a single peer. Pass in
+	// defaultNodeKey to select the changes to the default limit.
+	Peer *route.Vertex
+
+	// Limit is the maximum number of changes to return. Zero means no
+	// limit.
+	Limit int
+}
+
+// getLimit returns the limit that is stored for a peer of a node, or nil if
+// there is none.
+func (d *Db) getLimit(ctx context.Context, tx *sql.Tx, node,
+	peer route.Vertex) (*Limit, error) {
+
+	const query = `SELECT htlc_max_pending, htlc_max_hourly_rate, mode
+		FROM limits WHERE node = ? AND peer = ?;`
+
+	var (
+		limit   Limit
+		modeStr string
+	)
+	row := tx.QueryRowContext(
+		ctx, d.rebind(query), hex.EncodeToString(node[:]),
+		hex.EncodeToString(peer[:]),
+	)
+	err := row.Scan(&limit.MaxPending, &limit.MaxHourlyRate, &modeStr)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	limit.Mode, err = parseMode(modeStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &limit, nil
+}
+
+// limitColumns returns the column values of an optional limit.
+func limitColumns(limit *Limit) (sql.NullInt64, sql.NullInt64,
+	sql.NullString) {
+
+	if limit == nil {
+		return sql.NullInt64{}, sql.NullInt64{}, sql.NullString{}
+	}
+
+	return sql.NullInt64{Int64: limit.MaxPending, Valid: true},
+		sql.NullInt64{Int64: limit.MaxHourlyRate, Valid: true},
+		sql.NullString{String: limit.Mode.String(), Valid: true}
+}
+
+// recordLimitChange adds a change to the limit changes table. Nothing is
+// recorded if the limit didn't change, unless the change is a revert. Reverts
+// are always recorded, so that the reverted change is marked as such.
+func (d *Db) recordLimitChange(ctx context.Context, tx *sql.Tx, node,
+	peer route.Vertex, oldLimit, newLimit *Limit, caller string,
+	revertedChange int64) error {
+
+	switch {
+	case revertedChange != 0:
+
+	case oldLimit == nil && newLimit == nil:
+		return nil
+
+	case oldLimit != nil && newLimit != nil && *oldLimit == *newLimit:
+		return nil
+	}
+
+	oldPending, oldRate, oldMode := limitColumns(oldLimit)
+	newPending, newRate, newMode := limitColumns(newLimit)
+
+	reverted := sql.NullInt64{
+		Int64: revertedChange,
+		Valid: revertedChange != 0,
+	}
+
+	const insert = `INSERT INTO limit_changes (node, peer, change_time,
+		old_htlc_max_pending, old_htlc_max_hourly_rate, old_mode,
+		new_htlc_max_pending, new_htlc_max_hourly_rate, new_mode, caller,
+		reverted_change_id)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`
+
+	_, err := tx.ExecContext(
+		ctx, d.rebind(insert), hex.EncodeToString(node[:]),
+		hex.EncodeToString(peer[:]), time.Now().UnixNano(),
+		oldPending, oldRate, oldMode, newPending, newRate, newMode,
+		caller, reverted,
+	)
+
+	return err
+}
+
+// scanLimit converts optional limit column values into a limit.
+func scanLimit(pending, rate sql.NullInt64, mode sql.NullString) (*Limit,
+	error) {
+
+	if !mode.Valid {
+		return nil, nil
+	}
+
+	limitMode, err := parseMode(mode.String)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Limit{
+		MaxPending:    pending.Int64,
+		MaxHourlyRate: rate.Int64,
+		Mode:          limitMode,
+	}, nil
+}
+
+// queryer is implemented by both database connections and transactions.
+type queryer interface {
+	QueryContext(ctx context.Context, query string,
+		args ...interface{}) (*sql.Rows, error)
+}
+
+// ListLimitChanges returns the limit changes of a node that match the query,
+// most recent first.
+func (d *Db) ListLimitChanges(ctx context.Context, node route.Vertex,
+	query *LimitChangesQuery) ([]*LimitChange, error) {
+
+	return d.listLimitChanges(ctx, d.db, node, query)
+}
+
+func (d *Db) listLimitChanges(ctx context.Context, q queryer,
+	node route.Vertex, query *LimitChangesQuery) ([]*LimitChange, error) {
+
+	var (
+		conditions = []string{"node = ?"}
+		args       = []interface{}{hex.EncodeToString(node[:])}
+	)
+
+	if query.Peer != nil {
+		conditions = append(conditions, "peer = ?")
+		args = append(args, hex.EncodeToString(query.Peer[:]))
+	}
+
+	list := fmt.Sprintf(`SELECT id, peer, change_time,
+		old_htlc_max_pending, old_htlc_max_hourly_rate, old_mode,
+		new_htlc_max_pending, new_htlc_max_hourly_rate, new_mode, caller,
+		reverted_change_id
+		FROM limit_changes
+		WHERE %v
+		ORDER BY id DESC`, strings.Join(conditions, " AND "))
+
+	if query.Limit > 0 {
+		list += " LIMIT ?"
+		args = append(args, query.Limit)
+	}
+
+	rows, err := q.QueryContext(ctx, d.rebind(list), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []*LimitChange
+	for rows.Next() {
+		var (
+			change              LimitChange
+			peer                string
+			changeTime          int64
+			oldPending, oldRate sql.NullInt64
+			newPending, newRate sql.NullInt64
+			oldMode, newMode    sql.NullString
+			reverted            sql.NullInt64
+		)
+
+		err := rows.Scan(
+			&change.ID, &peer, &changeTime, &oldPending, &oldRate,
+			&oldMode, &newPending, &newRate, &newMode, &change.Caller,
+			&reverted,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		change.RevertedChange = reverted.Int64
+
+		change.Time = time.Unix(0, changeTime)
+
+		change.Peer, err = route.NewVertexFromStr(peer)
+		if err != nil {
+			return nil, err
+		}
+
+		change.Old, err = scanLimit(oldPending, oldRate, oldMode)
+		if err != nil {
+			return nil, err
+		}
+
+		change.New, err = scanLimit(newPending, newRate, newMode)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, &change)
+	}
+
+	return changes, rows.Err()
+}
+
+// RevertLimit restores the limit of a peer of a node to what it was before the
+// most recent change that isn't a revert and hasn't been reverted yet. The
+// change is selected and reverted in a single transaction, so that concurrent
+// changes can't interleave. The limit that is restored is passed to check
+// before it is written. The update that was applied is returned.
+func (d *Db) RevertLimit(ctx context.Context, node, peer route.Vertex,
+	caller string, check func(*Limit) error) (*LimitUpdate, error) {
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	changes, err := d.listLimitChanges(
+		ctx, tx, node, &LimitChangesQuery{Peer: &peer},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	change := changeToRevert(changes)
+	if change == nil {
+		return nil, fmt.Errorf("no limit changes to revert for %v", peer)
+	}
+
+	// If the limit is nil, the peer didn't have a limit of its own before
+	// the change and the limit is cleared.
+	if err := check(change.Old); err != nil {
+		return nil, err
+	}
+
+	update := LimitUpdate{
+		Peer:           peer,
+		Limit:          change.Old,
+		RevertedChange: change.ID,
+	}
+	if err := d.updateLimit(ctx, tx, node, update, caller); err != nil {
+		return nil, err
+	}
+
+	return &update, tx.Commit()
+}
+
+// changeToRevert returns the most recent of the changes provided, most recent
+// first, that isn't a revert and hasn't been reverted. Reverting does then not
+// toggle between the last two limits, but steps back through the history.
+func changeToRevert(changes []*LimitChange) *LimitChange {
+	reverted := make(map[int64]bool)
+	for _, change := range changes {
+		if change.RevertedChange != 0 {
+			reverted[change.RevertedChange] = true
+
+			continue
+		}
+
+		if !reverted[change.ID] {
+			return change
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// holdTimeKnownCondition selects the forwards of which the add time is
+	// known. Htlcs imported from lnd have no add time, and unknown add
+	// times are stored as times before the unix epoch.
+	holdTimeKnownCondition = `source <> 'lnd' AND add_time > 0`
+
+	// holdTimeExpression computes the hold time of a forward.
+	holdTimeExpression = `CASE WHEN resolved_time > add_time
+		THEN resolved_time - add_time ELSE 0 END`
+)
+
+// GetPeerStats computes the forwarding statistics of a peer for each of the
+// windows provided, which end at the time provided. Htlcs are assigned to
+// windows by their add time. The statistics are aggregated by the database,
+// so that the forwarding history doesn't need to be loaded.
+func (d *Db) GetPeerStats(ctx context.Context, node, peer route.Vertex,
+	windows []time.Duration, now time.Time) ([]*peerWindowStats, error) {
+
+	stats := make([]*peerWindowStats, len(windows))
+	for i, window := range windows {
+		incoming, err := d.getDirectionStats(
+			ctx, node, "incoming_peer", peer, now.Add(-window), now,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		outgoing, err := d.getDirectionStats(
+			ctx, node, "outgoing_peer", peer, now.Add(-window), now,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		stats[i] = &peerWindowStats{
+			window:   window,
+			incoming: *incoming,
+			outgoing: *outgoing,
+		}
+	}
+
+	return stats, nil
+}
+
+// getDirectionStats computes the statistics of the forwards of which the peer
+// column matches the peer provided and that were added between start and end.
+func (d *Db) getDirectionStats(ctx context.Context, node route.Vertex,
+	peerColumn string, peer route.Vertex, start, end time.Time) (
+	*directionStats, error) {
+
+	where := fmt.Sprintf(`node = ? AND %v = ? AND add_time >= ? AND
+		add_time < ?`, peerColumn)
+	args := []interface{}{
+		hex.EncodeToString(node[:]), hex.EncodeToString(peer[:]),
+		start.UnixNano(), end.UnixNano(),
+	}
+
+	aggregate := fmt.Sprintf(`SELECT
+		COUNT(CASE WHEN settled THEN 1 END),
+		COUNT(CASE WHEN NOT settled THEN 1 END),
+		CAST(COALESCE(SUM(CASE
+			WHEN settled AND incoming_amt_msat > outgoing_amt_msat
+			THEN incoming_amt_msat - outgoing_amt_msat ELSE 0 END), 0)
+			AS BIGINT),
+		COUNT(CASE WHEN %v THEN 1 END)
+		FROM forwarding_history WHERE %v;`,
+		holdTimeKnownCondition, where)
+
+	var (
+		stats         directionStats
+		holdTimeCount int
+	)
+	err := d.db.QueryRowContext(ctx, d.rebind(aggregate), args...).Scan(
+		&stats.settled, &stats.failed, &stats.fees, &holdTimeCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if holdTimeCount == 0 {
+		return &stats, nil
+	}
+
+	// Look up the hold time at the rank of each percentile.
+	percentile := fmt.Sprintf(`SELECT %v AS hold_time
+		FROM forwarding_history WHERE %v AND %v
+		ORDER BY hold_time LIMIT 1 OFFSET ?;`,
+		holdTimeExpression, where, holdTimeKnownCondition)
+
+	for _, p := range []struct {
+		percentile float64
+		holdTime   *time.Duration
+	}{
+		{50, &stats.holdTimeP50},
+		{90, &stats.holdTimeP90},
+		{99, &stats.holdTimeP99},
+	} {
+		rank := percentileRank(p.percentile, holdTimeCount)
+
+		err := d.db.QueryRowContext(
+			ctx, d.rebind(percentile),
+			append(args, rank-1)...,
+		).Scan(p.holdTime)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &stats, nil
+}
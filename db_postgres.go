@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+
+	_ "github.com/jackc/pgx/v4/stdlib"
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+// postgresMigrations is the migration set for the postgres backend. Postgres
+// support was added after nodes were namespaced, so the schema starts out at
+// the state of the sqlite schema after its migration 4.
+var postgresMigrations = &migrate.MemoryMigrationSource{
+	Migrations: []*migrate.Migration{
+		{
+			Id: "1",
+			Up: []string{
+				`CREATE TABLE IF NOT EXISTS limits (
+					node TEXT NOT NULL DEFAULT '',
+					peer TEXT NOT NULL,
+					htlc_max_pending BIGINT NOT NULL,
+					htlc_max_hourly_rate BIGINT NOT NULL,
+					mode TEXT CHECK(mode IN ('FAIL', 'QUEUE', 'QUEUE_PEER_INITIATED', 'BLOCK')) NOT NULL DEFAULT 'FAIL',
+
+					PRIMARY KEY (node, peer)
+				);`,
+				`CREATE TABLE IF NOT EXISTS forwarding_history (
+					node TEXT NOT NULL DEFAULT '',
+					add_time BIGINT NOT NULL,
+					resolved_time BIGINT NOT NULL,
+					settled BOOLEAN NOT NULL,
+					incoming_amt_msat BIGINT NOT NULL CHECK (incoming_amt_msat > 0),
+					outgoing_amt_msat BIGINT NOT NULL CHECK (outgoing_amt_msat > 0),
+					incoming_peer TEXT NOT NULL,
+					incoming_channel BIGINT NOT NULL,
+					incoming_htlc_index BIGINT NOT NULL,
+					outgoing_peer TEXT NOT NULL,
+					outgoing_channel BIGINT NOT NULL,
+					outgoing_htlc_index BIGINT NOT NULL,
+
+					CONSTRAINT unique_incoming_circuit UNIQUE (node, incoming_channel, incoming_htlc_index),
+					CONSTRAINT unique_outgoing_circuit UNIQUE (node, outgoing_channel, outgoing_htlc_index)
+				);`,
+				`CREATE INDEX add_time_index ON forwarding_history (add_time);`,
+				`CREATE INDEX node_add_time_index ON forwarding_history (node, add_time);`,
+			},
+		},
+		{
+			Id: "2",
+			Up: []string{
+				rollupTableSchema(granularityHour.table()),
+				rollupTableSchema(granularityDay.table()),
+			},
+		},
+		{
+			Id: "3",
+			Up: fwdHistoryIndexMigration,
+		},
+		{
+			Id: "4",
+			Up: fwdHistorySourceMigration,
+		},
+		{
+			Id: "5",
+			Up: limitChangesTableSchema("BIGSERIAL PRIMARY KEY"),
+		},
+		{
+			Id: "6",
+			Up: queueActionsTableSchema("BIGSERIAL PRIMARY KEY"),
+		},
+		{
+			Id: "7",
+			Up: healthCheckTableSchema,
+		},
+		{
+			Id: "8",
+			Up: processStateTableSchema,
+		},
+		{
+			Id: "9",
+			Up: historyWatermarksTableSchema,
+		},
+		{
+			Id: "10",
+			Up: limitChangesRevertMigration,
+		},
+		{
+			Id: "11",
+			Up: rollupHoldTimeCountMigration,
+		},
+	},
+}
+
+// NewPostgresDb opens a postgres database using the connection string
+// provided and applies the postgres migrations.
+func NewPostgresDb(ctx context.Context, dsn string, fwdHistoryLimit int) (*Db,
+	error) {
+
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+
+		return nil, err
+	}
+
+	return newDb(
+		ctx, db, backendPostgres, "postgres", postgresMigrations,
+		fwdHistoryLimit,
+	)
+}
@@ -0,0 +1,243 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// processStateTableSchema is the schema of the tables that keep the state of a
+// process across an orderly shutdown.
+var processStateTableSchema = []string{
+	`CREATE TABLE IF NOT EXISTS saved_rate_counters (
+		node TEXT NOT NULL,
+		peer TEXT NOT NULL,
+		interval_secs BIGINT NOT NULL,
+		save_time BIGINT NOT NULL,
+		success BIGINT NOT NULL,
+		fail BIGINT NOT NULL,
+		reject BIGINT NOT NULL,
+		PRIMARY KEY (node, peer, interval_secs)
+	);`,
+	`CREATE TABLE IF NOT EXISTS saved_in_flight_htlcs (
+		node TEXT NOT NULL,
+		peer TEXT NOT NULL,
+		incoming_channel BIGINT NOT NULL,
+		incoming_htlc_index BIGINT NOT NULL,
+		add_time BIGINT NOT NULL,
+		incoming_amt_msat BIGINT NOT NULL,
+		outgoing_amt_msat BIGINT NOT NULL,
+		PRIMARY KEY (node, incoming_channel, incoming_htlc_index)
+	);`,
+}
+
+// ProcessState is the state of a process that is saved on shutdown and restored
+// on the next start.
+type ProcessState struct {
+	// Time is the time at which the state was saved.
+	Time time.Time
+
+	Counters []*SavedRateCounter
+	InFlight []*SavedInFlightHtlc
+}
+
+// SavedRateCounter holds the counts of a peer over one of the rate counter
+// intervals.
+type SavedRateCounter struct {
+	Peer     route.Vertex
+	Interval time.Duration
+
+	Success, Fail, Reject int64
+}
+
+// SavedInFlightHtlc is an htlc that was forwarded, but not yet resolved.
+type SavedInFlightHtlc struct {
+	Peer            route.Vertex
+	IncomingCircuit circuitKey
+	AddTime         time.Time
+	IncomingMsat    lnwire.MilliSatoshi
+	OutgoingMsat    lnwire.MilliSatoshi
+}
+
+// SaveProcessState replaces the saved state of a node.
+func (d *Db) SaveProcessState(ctx context.Context, node route.Vertex,
+	state *ProcessState) error {
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	nodeKey := hex.EncodeToString(node[:])
+
+	if err := d.deleteProcessState(ctx, tx, nodeKey); err != nil {
+		return err
+	}
+
+	const insertCounter = `INSERT INTO saved_rate_counters (node, peer,
+		interval_secs, save_time, success, fail, reject)
+		VALUES (?, ?, ?, ?, ?, ?, ?);`
+
+	for _, counter := range state.Counters {
+		_, err := tx.ExecContext(
+			ctx, d.rebind(insertCounter), nodeKey,
+			hex.EncodeToString(counter.Peer[:]),
+			int64(counter.Interval.Seconds()), state.Time.UnixNano(),
+			counter.Success, counter.Fail, counter.Reject,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	const insertHtlc = `INSERT INTO saved_in_flight_htlcs (node, peer,
+		incoming_channel, incoming_htlc_index, add_time,
+		incoming_amt_msat, outgoing_amt_msat)
+		VALUES (?, ?, ?, ?, ?, ?, ?);`
+
+	for _, htlc := range state.InFlight {
+		_, err := tx.ExecContext(
+			ctx, d.rebind(insertHtlc), nodeKey,
+			hex.EncodeToString(htlc.Peer[:]),
+			htlc.IncomingCircuit.channel, htlc.IncomingCircuit.htlc,
+			htlc.AddTime.UnixNano(), uint64(htlc.IncomingMsat),
+			uint64(htlc.OutgoingMsat),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// TakeProcessState returns the saved state of a node and removes it, so that it
+// is restored only once. If no state was saved, an empty state is returned.
+func (d *Db) TakeProcessState(ctx context.Context, node route.Vertex) (
+	*ProcessState, error) {
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	nodeKey := hex.EncodeToString(node[:])
+	state := &ProcessState{}
+
+	const listCounters = `SELECT peer, interval_secs, save_time, success,
+		fail, reject FROM saved_rate_counters WHERE node = ?;`
+
+	rows, err := tx.QueryContext(ctx, d.rebind(listCounters), nodeKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			counter            SavedRateCounter
+			peer               string
+			intervalSecs, save int64
+		)
+
+		err := rows.Scan(
+			&peer, &intervalSecs, &save, &counter.Success,
+			&counter.Fail, &counter.Reject,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		counter.Peer, err = route.NewVertexFromStr(peer)
+		if err != nil {
+			return nil, err
+		}
+		counter.Interval = time.Duration(intervalSecs) * time.Second
+		state.Time = time.Unix(0, save)
+
+		state.Counters = append(state.Counters, &counter)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	const listHtlcs = `SELECT peer, incoming_channel, incoming_htlc_index,
+		add_time, incoming_amt_msat, outgoing_amt_msat
+		FROM saved_in_flight_htlcs WHERE node = ?;`
+
+	rows, err = tx.QueryContext(ctx, d.rebind(listHtlcs), nodeKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			htlc                       SavedInFlightHtlc
+			peer                       string
+			addTime                    int64
+			incomingMsat, outgoingMsat uint64
+		)
+
+		err := rows.Scan(
+			&peer, &htlc.IncomingCircuit.channel,
+			&htlc.IncomingCircuit.htlc, &addTime, &incomingMsat,
+			&outgoingMsat,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		htlc.Peer, err = route.NewVertexFromStr(peer)
+		if err != nil {
+			return nil, err
+		}
+		htlc.AddTime = time.Unix(0, addTime)
+		htlc.IncomingMsat = lnwire.MilliSatoshi(incomingMsat)
+		htlc.OutgoingMsat = lnwire.MilliSatoshi(outgoingMsat)
+
+		state.InFlight = append(state.InFlight, &htlc)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := d.deleteProcessState(ctx, tx, nodeKey); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// deleteProcessState removes the saved state of a node.
+func (d *Db) deleteProcessState(ctx context.Context, tx *sql.Tx,
+	nodeKey string) error {
+
+	for _, table := range []string{
+		"saved_rate_counters", "saved_in_flight_htlcs",
+	} {
+		_, err := tx.ExecContext(
+			ctx, d.rebind("DELETE FROM "+table+" WHERE node = ?;"),
+			nodeKey,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
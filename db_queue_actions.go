@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// queueActionsTableSchema returns the schema of the queue actions table. The id
+// column definition differs between sqlite and postgres.
+func queueActionsTableSchema(idColumn string) []string {
+	return []string{
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS queue_actions (
+			id %v,
+			node TEXT NOT NULL,
+			peer TEXT NOT NULL,
+			action_time BIGINT NOT NULL,
+			action TEXT NOT NULL CHECK(action IN ('forward', 'fail')),
+			incoming_channel BIGINT NOT NULL,
+			incoming_htlc_index BIGINT NOT NULL,
+			outgoing_channel BIGINT NOT NULL,
+			incoming_amt_msat BIGINT NOT NULL,
+			outgoing_amt_msat BIGINT NOT NULL,
+			queued_time BIGINT NOT NULL,
+			caller TEXT NOT NULL
+		);`, idColumn),
+		`CREATE INDEX queue_actions_peer_index ON queue_actions (node, peer, id);`,
+	}
+}
+
+// QueueActionRecord is an operator action on a queued htlc.
+type QueueActionRecord struct {
+	ID     int64
+	Time   time.Time
+	Peer   route.Vertex
+	Action queueAction
+
+	IncomingCircuit circuitKey
+	OutgoingChannel uint64
+	IncomingMsat    lnwire.MilliSatoshi
+	OutgoingMsat    lnwire.MilliSatoshi
+
+	// QueuedTime is the time at which the htlc was queued.
+	QueuedTime time.Time
+
+	// Caller identifies who took the action.
+	Caller string
+}
+
+// QueueActionsQuery selects the queue actions to return.
+type QueueActionsQuery struct {
+	// Peer optionally restricts the actions to a single peer.
+	Peer *route.Vertex
+
+	// Limit is the maximum number of actions to return. Zero means no
+	// limit.
+	Limit int
+}
+
+// RecordQueueActions adds operator actions on queued htlcs to the history.
+func (d *Db) RecordQueueActions(ctx context.Context, node route.Vertex,
+	actions []*QueueActionRecord) error {
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	const insert = `INSERT INTO queue_actions (node, peer, action_time,
+		action, incoming_channel, incoming_htlc_index, outgoing_channel,
+		incoming_amt_msat, outgoing_amt_msat, queued_time, caller)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`
+
+	for _, action := range actions {
+		_, err := tx.ExecContext(
+			ctx, d.rebind(insert), hex.EncodeToString(node[:]),
+			hex.EncodeToString(action.Peer[:]),
+			action.Time.UnixNano(), action.Action.String(),
+			action.IncomingCircuit.channel,
+			action.IncomingCircuit.htlc, action.OutgoingChannel,
+			uint64(action.IncomingMsat), uint64(action.OutgoingMsat),
+			action.QueuedTime.UnixNano(), action.Caller,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListQueueActions returns the queue actions of a node that match the query,
+// most recent first.
+func (d *Db) ListQueueActions(ctx context.Context, node route.Vertex,
+	query *QueueActionsQuery) ([]*QueueActionRecord, error) {
+
+	var (
+		conditions = []string{"node = ?"}
+		args       = []interface{}{hex.EncodeToString(node[:])}
+	)
+
+	if query.Peer != nil {
+		conditions = append(conditions, "peer = ?")
+		args = append(args, hex.EncodeToString(query.Peer[:]))
+	}
+
+	list := fmt.Sprintf(`SELECT id, peer, action_time, action,
+		incoming_channel, incoming_htlc_index, outgoing_channel,
+		incoming_amt_msat, outgoing_amt_msat, queued_time, caller
+		FROM queue_actions
+		WHERE %v
+		ORDER BY id DESC`, strings.Join(conditions, " AND "))
+
+	if query.Limit > 0 {
+		list += " LIMIT ?"
+		args = append(args, query.Limit)
+	}
+
+	rows, err := d.db.QueryContext(ctx, d.rebind(list), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var actions []*QueueActionRecord
+	for rows.Next() {
+		var (
+			action                     QueueActionRecord
+			peer, actionStr            string
+			actionTime, queuedTime     int64
+			incomingMsat, outgoingMsat uint64
+		)
+
+		err := rows.Scan(
+			&action.ID, &peer, &actionTime, &actionStr,
+			&action.IncomingCircuit.channel,
+			&action.IncomingCircuit.htlc, &action.OutgoingChannel,
+			&incomingMsat, &outgoingMsat, &queuedTime,
+			&action.Caller,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		action.Time = time.Unix(0, actionTime)
+		action.QueuedTime = time.Unix(0, queuedTime)
+		action.IncomingMsat = lnwire.MilliSatoshi(incomingMsat)
+		action.OutgoingMsat = lnwire.MilliSatoshi(outgoingMsat)
+
+		action.Peer, err = route.NewVertexFromStr(peer)
+		if err != nil {
+			return nil, err
+		}
+
+		action.Action, err = parseQueueAction(actionStr)
+		if err != nil {
+			return nil, err
+		}
+
+		actions = append(actions, &action)
+	}
+
+	return actions, rows.Err()
+}
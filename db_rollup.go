@@ -0,0 +1,283 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// rollupGranularity is the bucket size of a forwarding rollup table.
+type rollupGranularity int
+
+const (
+	granularityHour rollupGranularity = iota
+	granularityDay
+)
+
+// rollupGranularities lists all granularities for which rollups are
+// maintained.
+var rollupGranularities = []rollupGranularity{
+	granularityHour, granularityDay,
+}
+
+func (g rollupGranularity) table() string {
+	switch g {
+	case granularityHour:
+		return "forwarding_rollup_hourly"
+
+	case granularityDay:
+		return "forwarding_rollup_daily"
+
+	default:
+		panic("unknown granularity")
+	}
+}
+
+func (g rollupGranularity) bucketSize() time.Duration {
+	switch g {
+	case granularityHour:
+		return time.Hour
+
+	case granularityDay:
+		return 24 * time.Hour
+
+	default:
+		panic("unknown granularity")
+	}
+}
+
+// bucketStart returns the start of the bucket that a htlc resolved at time t
+// falls into. Buckets are aligned to UTC hours and days.
+func (g rollupGranularity) bucketStart(t time.Time) time.Time {
+	return t.Truncate(g.bucketSize())
+}
+
+// rollupTableSchema returns the schema of a rollup table. The column types are
+// valid for both sqlite and postgres.
+func rollupTableSchema(table string) string {
+	return fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %v (
+		node TEXT NOT NULL,
+		bucket_start BIGINT NOT NULL,
+		incoming_peer TEXT NOT NULL,
+		outgoing_peer TEXT NOT NULL,
+		settled_count BIGINT NOT NULL,
+		failed_count BIGINT NOT NULL,
+		settled_amt_msat BIGINT NOT NULL,
+		fees_msat BIGINT NOT NULL,
+		hold_time_ns BIGINT NOT NULL,
+
+		PRIMARY KEY (node, bucket_start, incoming_peer, outgoing_peer)
+	);`, table)
+}
+
+// rollupHoldTimeCountMigration adds the number of htlcs with a known hold time
+// to the rollup tables. Before, only imported htlcs had an unknown hold time,
+// and those were never rolled up in the same buckets as live htlcs. Buckets
+// with a hold time are therefore assumed to consist of live htlcs only.
+var rollupHoldTimeCountMigration = func() []string {
+	var migration []string
+	for _, granularity := range rollupGranularities {
+		table := granularity.table()
+
+		migration = append(migration,
+			fmt.Sprintf(`ALTER TABLE %v ADD COLUMN hold_time_count
+				BIGINT NOT NULL DEFAULT 0;`, table),
+			fmt.Sprintf(`UPDATE %v
+				SET hold_time_count = settled_count + failed_count
+				WHERE hold_time_ns > 0;`, table),
+		)
+	}
+
+	return migration
+}()
+
+// ForwardingStats are the aggregated forwards between an incoming and outgoing
+// peer within a time bucket.
+type ForwardingStats struct {
+	BucketStart  time.Time
+	IncomingPeer route.Vertex
+	OutgoingPeer route.Vertex
+
+	SettledCount int64
+	FailedCount  int64
+
+	// SettledAmt is the total outgoing amount of the settled htlcs.
+	SettledAmt lnwire.MilliSatoshi
+
+	// Fees is the total fee earned with the settled htlcs.
+	Fees lnwire.MilliSatoshi
+
+	// HoldTime is the total time that htlcs were in flight. Htlcs of which
+	// the add time is unknown are not included.
+	HoldTime time.Duration
+
+	// HoldTimeCount is the number of htlcs that are included in HoldTime.
+	HoldTimeCount int64
+}
+
+// AvgHoldTime returns the average time that the htlcs of which the add time is
+// known were in flight, or zero if there are none.
+func (f *ForwardingStats) AvgHoldTime() time.Duration {
+	if f.HoldTimeCount == 0 {
+		return 0
+	}
+
+	return f.HoldTime / time.Duration(f.HoldTimeCount)
+}
+
+// ForwardingStatsQuery selects the rollups to return.
+type ForwardingStatsQuery struct {
+	Granularity rollupGranularity
+
+	// Start is the inclusive start time of the first bucket and End is the
+	// exclusive end time of the last bucket.
+	Start, End time.Time
+
+	// IncomingPeer and OutgoingPeer optionally restrict the rollups to
+	// those peers.
+	IncomingPeer *route.Vertex
+	OutgoingPeer *route.Vertex
+}
+
+// updateRollups adds a resolved htlc to the rollup tables of all
+// granularities.
+func (d *Db) updateRollups(ctx context.Context, tx *sql.Tx,
+	node route.Vertex, htlc *HtlcInfo) error {
+
+	var (
+		settledCount, failedCount int64
+		settledAmt, fees          uint64
+	)
+
+	if htlc.settled {
+		settledCount = 1
+		settledAmt = uint64(htlc.outgoingMsat)
+
+		if htlc.incomingMsat > htlc.outgoingMsat {
+			fees = uint64(htlc.incomingMsat - htlc.outgoingMsat)
+		}
+	} else {
+		failedCount = 1
+	}
+
+	// Htlcs with an unknown add time don't contribute to the hold time.
+	var holdTimeCount int64
+	holdTime, ok := htlc.holdTime()
+	if ok {
+		holdTimeCount = 1
+	}
+
+	for _, granularity := range rollupGranularities {
+		table := granularity.table()
+
+		upsert := fmt.Sprintf(`INSERT INTO %[1]v (node, bucket_start,
+			incoming_peer, outgoing_peer, settled_count, failed_count,
+			settled_amt_msat, fees_msat, hold_time_ns, hold_time_count)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (node, bucket_start, incoming_peer, outgoing_peer)
+			DO UPDATE SET
+				settled_count = %[1]v.settled_count + excluded.settled_count,
+				failed_count = %[1]v.failed_count + excluded.failed_count,
+				settled_amt_msat = %[1]v.settled_amt_msat + excluded.settled_amt_msat,
+				fees_msat = %[1]v.fees_msat + excluded.fees_msat,
+				hold_time_ns = %[1]v.hold_time_ns + excluded.hold_time_ns,
+				hold_time_count = %[1]v.hold_time_count + excluded.hold_time_count;`,
+			table)
+
+		_, err := tx.ExecContext(
+			ctx, d.rebind(upsert),
+			hex.EncodeToString(node[:]),
+			granularity.bucketStart(htlc.resolveTime).UnixNano(),
+			hex.EncodeToString(htlc.incomingPeer[:]),
+			hex.EncodeToString(htlc.outgoingPeer[:]),
+			settledCount, failedCount, settledAmt, fees,
+			holdTime.Nanoseconds(), holdTimeCount,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ListForwardingStats returns the rollups of a node that match the query,
+// ordered by bucket start.
+func (d *Db) ListForwardingStats(ctx context.Context, node route.Vertex,
+	query *ForwardingStatsQuery) ([]*ForwardingStats, error) {
+
+	var (
+		conditions = []string{
+			"node = ?", "bucket_start >= ?", "bucket_start < ?",
+		}
+		args = []interface{}{
+			hex.EncodeToString(node[:]), query.Start.UnixNano(),
+			query.End.UnixNano(),
+		}
+	)
+
+	if query.IncomingPeer != nil {
+		conditions = append(conditions, "incoming_peer = ?")
+		args = append(args, hex.EncodeToString(query.IncomingPeer[:]))
+	}
+
+	if query.OutgoingPeer != nil {
+		conditions = append(conditions, "outgoing_peer = ?")
+		args = append(args, hex.EncodeToString(query.OutgoingPeer[:]))
+	}
+
+	list := fmt.Sprintf(`SELECT bucket_start, incoming_peer, outgoing_peer,
+		settled_count, failed_count, settled_amt_msat, fees_msat,
+		hold_time_ns, hold_time_count
+		FROM %v
+		WHERE %v
+		ORDER BY bucket_start, incoming_peer, outgoing_peer;`,
+		query.Granularity.table(), strings.Join(conditions, " AND "))
+
+	rows, err := d.db.QueryContext(ctx, d.rebind(list), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []*ForwardingStats
+	for rows.Next() {
+		var (
+			bucketStart, holdTime      int64
+			incomingPeer, outgoingPeer string
+			stat                       ForwardingStats
+		)
+
+		err := rows.Scan(
+			&bucketStart, &incomingPeer, &outgoingPeer,
+			&stat.SettledCount, &stat.FailedCount, &stat.SettledAmt,
+			&stat.Fees, &holdTime, &stat.HoldTimeCount,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		stat.BucketStart = time.Unix(0, bucketStart)
+		stat.HoldTime = time.Duration(holdTime)
+
+		stat.IncomingPeer, err = route.NewVertexFromStr(incomingPeer)
+		if err != nil {
+			return nil, err
+		}
+
+		stat.OutgoingPeer, err = route.NewVertexFromStr(outgoingPeer)
+		if err != nil {
+			return nil, err
+		}
+
+		stats = append(stats, &stat)
+	}
+
+	return stats, rows.Err()
+}
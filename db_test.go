@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/stretchr/testify/require"
 )
@@ -22,17 +23,46 @@ func setupTestDb(t *testing.T, fwdingHistoryLimit int) (*Db, func()) {
 	}
 }
 
+// UpdateLimit sets the limit for a peer of a node. The default limit is set by
+// passing in defaultNodeKey as the peer.
+func (d *Db) UpdateLimit(ctx context.Context, node, peer route.Vertex,
+	limit Limit, caller string) error {
+
+	return d.UpdateLimits(
+		ctx, node, []LimitUpdate{{Peer: peer, Limit: &limit}}, caller,
+	)
+}
+
+// ClearLimit removes the limit for a peer of a node, so that the default limit
+// applies.
+func (d *Db) ClearLimit(ctx context.Context, node, peer route.Vertex,
+	caller string) error {
+
+	return d.UpdateLimits(ctx, node, []LimitUpdate{{Peer: peer}}, caller)
+}
+
+// testNodeKey is the lnd node that test data is stored for.
+var testNodeKey = route.Vertex{100}
+
 func TestDb(t *testing.T) {
-	ctx := context.Background()
 	db, cleanup := setupTestDb(t, defaultFwdHistoryLimit)
 	defer cleanup()
+	defer db.Close()
+
+	testDbLimits(t, db)
+}
+
+func testDbLimits(t *testing.T, db *Db) {
+	ctx := context.Background()
+
+	require.NoError(t, db.InitNode(ctx, testNodeKey, true))
 
 	expectedDefaultLimit := Limit{
 		MaxPending:    5,
 		MaxHourlyRate: 3600,
 	}
 
-	limits, err := db.GetLimits(ctx)
+	limits, err := db.GetLimits(ctx, testNodeKey)
 	require.NoError(t, err)
 	require.Equal(t, expectedDefaultLimit, limits.Default)
 	require.Len(t, limits.PerPeer, 0)
@@ -44,63 +74,206 @@ func TestDb(t *testing.T) {
 		Mode:          ModeQueue,
 	}
 
-	require.NoError(t, db.UpdateLimit(ctx, peer, limit))
+	require.NoError(t, db.UpdateLimit(ctx, testNodeKey, peer, limit, "test"))
 
-	limits, err = db.GetLimits(ctx)
+	limits, err = db.GetLimits(ctx, testNodeKey)
 	require.NoError(t, err)
 	require.Equal(t, expectedDefaultLimit, limits.Default)
 	require.Equal(t, map[route.Vertex]Limit{peer: limit}, limits.PerPeer)
 
-	require.NoError(t, db.UpdateLimit(ctx, defaultNodeKey, limit))
+	require.NoError(t, db.UpdateLimit(
+		ctx, testNodeKey, defaultNodeKey, limit, "test",
+	))
 
-	limits, err = db.GetLimits(ctx)
+	limits, err = db.GetLimits(ctx, testNodeKey)
 	require.NoError(t, err)
 	require.Equal(t, limit, limits.Default)
 
-	require.NoError(t, db.ClearLimit(ctx, peer))
+	require.NoError(t, db.ClearLimit(ctx, testNodeKey, peer, "admin"))
 
-	limits, err = db.GetLimits(ctx)
+	limits, err = db.GetLimits(ctx, testNodeKey)
 	require.NoError(t, err)
 	require.Len(t, limits.PerPeer, 0)
 
-	require.Error(t, db.ClearLimit(ctx, defaultNodeKey))
+	require.Error(t, db.ClearLimit(ctx, testNodeKey, defaultNodeKey, "test"))
 
-	defer db.Close()
+	// Setting a limit to its current value isn't recorded as a change.
+	require.NoError(t, db.UpdateLimit(
+		ctx, testNodeKey, defaultNodeKey, limit, "test",
+	))
+
+	// All changes are recorded, most recent first.
+	changes, err := db.ListLimitChanges(
+		ctx, testNodeKey, &LimitChangesQuery{},
+	)
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+
+	require.Equal(t, peer, changes[0].Peer)
+	require.Equal(t, &limit, changes[0].Old)
+	require.Nil(t, changes[0].New)
+	require.Equal(t, "admin", changes[0].Caller)
+
+	require.Equal(t, defaultNodeKey, changes[1].Peer)
+	require.Equal(t, &expectedDefaultLimit, changes[1].Old)
+	require.Equal(t, &limit, changes[1].New)
+
+	require.Equal(t, peer, changes[2].Peer)
+	require.Nil(t, changes[2].Old)
+	require.Equal(t, &limit, changes[2].New)
+	require.Equal(t, "test", changes[2].Caller)
+
+	changes, err = db.ListLimitChanges(
+		ctx, testNodeKey, &LimitChangesQuery{Peer: &peer, Limit: 1},
+	)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Nil(t, changes[0].New)
 }
 
 func TestDbForwardingHistory(t *testing.T) {
-	limit := 20
+	// Create a test DB that will limit to 20 forwarding history records.
+	db, cleanup := setupTestDb(t, 20)
+	defer cleanup()
+	defer db.Close()
+
+	testDbForwardingHistory(t, db)
+}
 
-	// Create a test DB that will limit to 10 forwarding history records.
+func testDbForwardingHistory(t *testing.T, db *Db) {
 	ctx := context.Background()
-	db, cleanup := setupTestDb(t, limit)
-	defer cleanup()
+	limit := db.fwdHistoryLimit
 
-	// Insert HTLCs just up until our limit.
+	// Record a forward for another node, which is subject to its own limit.
+	otherNode := route.Vertex{101}
+	otherHtlc := testHtlc(0)
+	require.NoError(t, db.RecordHtlcResolution(ctx, otherNode, otherHtlc))
+
+	// Insert HTLCs just up until our limit. Pruning doesn't delete anything
+	// yet.
 	for i := 1; i < limit; i++ {
 		htlc := testHtlc(uint64(i))
-		require.NoError(t, db.RecordHtlcResolution(ctx, htlc))
+		require.NoError(t, db.RecordHtlcResolution(ctx, testNodeKey, htlc))
+		require.NoError(t, db.prune(ctx, 0, time.Now()))
 	}
 
 	endTime := time.Unix(100000, 0)
-	fwds, err := db.ListForwardingHistory(ctx, time.Time{}, endTime)
+	fwds, err := db.ListForwardingHistory(
+		ctx, testNodeKey, &ForwardingHistoryQuery{End: endTime},
+	)
 	require.NoError(t, err)
 	require.Len(t, fwds, limit-1)
 
-	// Insert a HTLC that reaches the limit and assert that we've removed old records
-	// but we still have the newest HTLC and we've culled the old one.
+	// Insert a HTLC that reaches the limit and assert that pruning removed old
+	// records but we still have the newest HTLC and we've culled the old one.
 	limitHtlc := testHtlc(20)
-	require.NoError(t, db.RecordHtlcResolution(ctx, limitHtlc))
+	require.NoError(t, db.RecordHtlcResolution(ctx, testNodeKey, limitHtlc))
+	require.NoError(t, db.prune(ctx, 0, time.Now()))
 
 	limitCount := limit - (limit / 10)
-	fwds, err = db.ListForwardingHistory(ctx, time.Time{}, endTime)
+	fwds, err = db.ListForwardingHistory(
+		ctx, testNodeKey, &ForwardingHistoryQuery{End: endTime},
+	)
 	require.NoError(t, err)
 	require.Len(t, fwds, limitCount)
 	require.Equal(t, limitHtlc, fwds[len(fwds)-1])
+
+	// The oldest forward overall belongs to the other node, which is still
+	// below its limit.
+	fwds, err = db.ListForwardingHistory(
+		ctx, otherNode, &ForwardingHistoryQuery{End: endTime},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*HtlcInfo{otherHtlc}, fwds)
+}
+
+func TestDbMultipleNodes(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := setupTestDb(t, defaultFwdHistoryLimit)
+	defer cleanup()
+	defer db.Close()
+
+	// Store a limit and a forward as they would have been stored before
+	// nodes were namespaced.
+	peer := route.Vertex{1}
+	limit := Limit{
+		MaxHourlyRate: 1,
+		MaxPending:    2,
+		Mode:          ModeBlock,
+	}
+	_, err := db.db.ExecContext(ctx, `INSERT INTO limits(peer,
+		htlc_max_pending, htlc_max_hourly_rate, mode) VALUES(?, 2, 1, 'BLOCK')`,
+		peer.String(),
+	)
+	require.NoError(t, err)
+
+	_, err = db.db.ExecContext(ctx, `INSERT INTO forwarding_history (add_time,
+		resolved_time, settled, incoming_amt_msat, outgoing_amt_msat,
+		incoming_peer, incoming_channel, incoming_htlc_index, outgoing_peer,
+		outgoing_channel, outgoing_htlc_index)
+		VALUES (1, 1, true, 50, 45, ?, 1, 1, ?, 2, 1)`,
+		peer.String(), peer.String(),
+	)
+	require.NoError(t, err)
+
+	node1, node2 := route.Vertex{101}, route.Vertex{102}
+	require.NoError(t, db.InitNode(ctx, node1, true))
+	require.NoError(t, db.InitNode(ctx, node2, false))
+
+	endTime := time.Unix(100000, 0)
+
+	// The legacy data is assigned to the first node only.
+	limits, err := db.GetLimits(ctx, node1)
+	require.NoError(t, err)
+	require.Equal(t, map[route.Vertex]Limit{peer: limit}, limits.PerPeer)
+	require.Equal(t, initialDefaultLimit, limits.Default)
+
+	fwds, err := db.ListForwardingHistory(
+		ctx, node1, &ForwardingHistoryQuery{End: endTime},
+	)
+	require.NoError(t, err)
+	require.Len(t, fwds, 1)
+
+	limits, err = db.GetLimits(ctx, node2)
+	require.NoError(t, err)
+	require.Len(t, limits.PerPeer, 0)
+	require.Equal(t, initialDefaultLimit, limits.Default)
+
+	fwds, err = db.ListForwardingHistory(
+		ctx, node2, &ForwardingHistoryQuery{End: endTime},
+	)
+	require.NoError(t, err)
+	require.Len(t, fwds, 0)
+
+	// The same circuit can be recorded for both nodes.
+	require.NoError(t, db.RecordHtlcResolution(ctx, node2, testHtlc(1)))
+
+	fwds, err = db.ListForwardingHistory(
+		ctx, node2, &ForwardingHistoryQuery{End: endTime},
+	)
+	require.NoError(t, err)
+	require.Len(t, fwds, 1)
+
+	// Updating the limit of one node doesn't affect the other.
+	require.NoError(t, db.UpdateLimit(
+		ctx, node2, defaultNodeKey, limit, "test",
+	))
+
+	limits, err = db.GetLimits(ctx, node1)
+	require.NoError(t, err)
+	require.Equal(t, initialDefaultLimit, limits.Default)
+
+	// Initializing again keeps existing limits.
+	require.NoError(t, db.InitNode(ctx, node2, false))
+
+	limits, err = db.GetLimits(ctx, node2)
+	require.NoError(t, err)
+	require.Equal(t, limit, limits.Default)
 }
 
 func testHtlc(i uint64) *HtlcInfo {
 	return &HtlcInfo{
+		source:       sourceLive,
 		addTime:      time.Unix(int64(i), 0),
 		resolveTime:  time.Unix(int64(i), 0),
 		settled:      true,
@@ -123,9 +296,13 @@ func TestDbNoForwardingHistory(t *testing.T) {
 	defer cleanup()
 
 	htlc := testHtlc(1)
-	require.NoError(t, db.RecordHtlcResolution(ctx, htlc))
+	require.NoError(t, db.RecordHtlcResolution(ctx, testNodeKey, htlc))
 
-	fwds, err := db.ListForwardingHistory(ctx, time.Time{}, time.Unix(1000000, 0))
+	fwds, err := db.ListForwardingHistory(
+		ctx, testNodeKey, &ForwardingHistoryQuery{
+			End: time.Unix(1000000, 0),
+		},
+	)
 	require.NoError(t, err)
 	require.Len(t, fwds, 0)
 }
@@ -138,9 +315,13 @@ func TestForwadingHistoryDelete(t *testing.T) {
 
 	// Write a test HTLC and assert that it's stored.
 	htlc := testHtlc(1)
-	require.NoError(t, db.RecordHtlcResolution(ctx, htlc))
+	require.NoError(t, db.RecordHtlcResolution(ctx, testNodeKey, htlc))
 
-	fwds, err := db.ListForwardingHistory(ctx, time.Time{}, time.Unix(1000000, 0))
+	fwds, err := db.ListForwardingHistory(
+		ctx, testNodeKey, &ForwardingHistoryQuery{
+			End: time.Unix(1000000, 0),
+		},
+	)
 	require.NoError(t, err)
 	require.Len(t, fwds, 1)
 
@@ -148,9 +329,286 @@ func TestForwadingHistoryDelete(t *testing.T) {
 	// the test db because it would re-create the file. Run limitHTLCRecords once
 	// (as we would on NewDb) to assert that we clean up our records.
 	db.fwdHistoryLimit = 0
-	require.NoError(t, db.limitHTLCRecords(ctx))
+	_, err = db.limitHTLCRecords(ctx)
+	require.NoError(t, err)
 
-	fwds, err = db.ListForwardingHistory(ctx, time.Time{}, time.Unix(1000000, 0))
+	fwds, err = db.ListForwardingHistory(
+		ctx, testNodeKey, &ForwardingHistoryQuery{
+			End: time.Unix(1000000, 0),
+		},
+	)
 	require.NoError(t, err)
 	require.Len(t, fwds, 0)
 }
+
+func TestDbRetention(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := setupTestDb(t, 8)
+	defer cleanup()
+	defer db.Close()
+
+	for i := 1; i <= 10; i++ {
+		htlc := testHtlc(uint64(i))
+		require.NoError(t, db.RecordHtlcResolution(ctx, testNodeKey, htlc))
+	}
+
+	// Prune everything that was resolved more than 5 seconds before now.
+	// This leaves htlcs 5 to 10, which is still within the row limit.
+	now := time.Unix(10, 0)
+	require.NoError(t, db.prune(ctx, 5*time.Second, now))
+
+	fwds, err := db.ListForwardingHistory(
+		ctx, testNodeKey, &ForwardingHistoryQuery{
+			End: time.Unix(1000000, 0),
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, fwds, 6)
+	require.Equal(t, testHtlc(5), fwds[0])
+
+	// Shorten the retention period.
+	require.NoError(t, db.prune(ctx, 2*time.Second, now))
+
+	fwds, err = db.ListForwardingHistory(
+		ctx, testNodeKey, &ForwardingHistoryQuery{
+			End: time.Unix(1000000, 0),
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, fwds, 3)
+
+	// Check that the row limit is still enforced when time-based pruning is
+	// disabled.
+	for i := 11; i <= 20; i++ {
+		htlc := testHtlc(uint64(i))
+		require.NoError(t, db.RecordHtlcResolution(ctx, testNodeKey, htlc))
+	}
+	require.NoError(t, db.prune(ctx, 0, now))
+
+	fwds, err = db.ListForwardingHistory(
+		ctx, testNodeKey, &ForwardingHistoryQuery{
+			End: time.Unix(1000000, 0),
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, fwds, 8-8/10)
+
+	// Limit changes and queue actions are audit logs that are never
+	// pruned.
+	require.NoError(t, db.UpdateLimit(
+		ctx, testNodeKey, route.Vertex{1}, Limit{MaxPending: 1}, "test",
+	))
+	require.NoError(t, db.RecordQueueActions(
+		ctx, testNodeKey, []*QueueActionRecord{{
+			Time:   time.Unix(1, 0),
+			Action: queueActionFail,
+			Caller: "test",
+		}},
+	))
+	require.NoError(t, db.prune(
+		ctx, time.Second, time.Now().Add(10*365*24*time.Hour),
+	))
+
+	changes, err := db.ListLimitChanges(
+		ctx, testNodeKey, &LimitChangesQuery{},
+	)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+
+	actions, err := db.ListQueueActions(
+		ctx, testNodeKey, &QueueActionsQuery{},
+	)
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+}
+
+func TestDbForwardingStats(t *testing.T) {
+	// Rollups are maintained even if no forwarding history is stored.
+	db, cleanup := setupTestDb(t, 0)
+	defer cleanup()
+	defer db.Close()
+
+	testDbForwardingStats(t, db)
+}
+
+func testDbForwardingStats(t *testing.T, db *Db) {
+	ctx := context.Background()
+
+	peer1, peer2 := route.Vertex{1}, route.Vertex{2}
+	// Midnight utc, so that the start is aligned to days.
+	start := time.Unix(1672531200, 0)
+
+	record := func(offset time.Duration, in, out route.Vertex,
+		settled bool) {
+
+		htlc := testHtlc(uint64(offset))
+		htlc.addTime = start.Add(offset)
+		htlc.resolveTime = htlc.addTime.Add(time.Second)
+		htlc.incomingPeer = in
+		htlc.outgoingPeer = out
+		htlc.settled = settled
+
+		require.NoError(t, db.RecordHtlcResolution(ctx, testNodeKey, htlc))
+	}
+
+	record(time.Minute, peer1, peer2, true)
+	record(2*time.Minute, peer1, peer2, false)
+	record(3*time.Minute, peer2, peer1, true)
+	record(2*time.Hour, peer1, peer2, true)
+
+	// A htlc of which the add time is unknown doesn't contribute to the
+	// hold time.
+	unknownAddTime := testHtlc(uint64(4 * time.Minute))
+	unknownAddTime.addTime = time.Time{}
+	unknownAddTime.resolveTime = start.Add(4 * time.Minute)
+	unknownAddTime.incomingPeer = peer1
+	unknownAddTime.outgoingPeer = peer2
+	require.NoError(t, db.RecordHtlcResolution(
+		ctx, testNodeKey, unknownAddTime,
+	))
+
+	query := &ForwardingStatsQuery{
+		Granularity: granularityHour,
+		Start:       start,
+		End:         start.Add(24 * time.Hour),
+	}
+	stats, err := db.ListForwardingStats(ctx, testNodeKey, query)
+	require.NoError(t, err)
+	require.Len(t, stats, 3)
+
+	require.Equal(t, &ForwardingStats{
+		BucketStart:   start,
+		IncomingPeer:  peer1,
+		OutgoingPeer:  peer2,
+		SettledCount:  2,
+		FailedCount:   1,
+		SettledAmt:    90,
+		Fees:          10,
+		HoldTime:      2 * time.Second,
+		HoldTimeCount: 2,
+	}, stats[0])
+	require.Equal(t, time.Second, stats[0].AvgHoldTime())
+	require.Equal(t, peer2, stats[1].IncomingPeer)
+	require.Equal(t, start.Add(2*time.Hour), stats[2].BucketStart)
+
+	// Aggregate by day and filter by peer.
+	query.Granularity = granularityDay
+	query.IncomingPeer = &peer1
+
+	stats, err = db.ListForwardingStats(ctx, testNodeKey, query)
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.Equal(t, int64(3), stats[0].SettledCount)
+	require.Equal(t, int64(1), stats[0].FailedCount)
+	require.Equal(t, lnwire.MilliSatoshi(15), stats[0].Fees)
+	require.Equal(t, int64(3), stats[0].HoldTimeCount)
+
+	// Stats of other nodes are not returned.
+	stats, err = db.ListForwardingStats(ctx, route.Vertex{99}, query)
+	require.NoError(t, err)
+	require.Len(t, stats, 0)
+}
+
+func TestDbForwardingHistoryQuery(t *testing.T) {
+	db, cleanup := setupTestDb(t, defaultFwdHistoryLimit)
+	defer cleanup()
+	defer db.Close()
+
+	testDbForwardingHistoryQuery(t, db)
+}
+
+func testDbForwardingHistoryQuery(t *testing.T, db *Db) {
+	ctx := context.Background()
+
+	peer1, peer2 := route.Vertex{1}, route.Vertex{2}
+
+	// Record ten htlcs, alternating peers and outcome. Htlcs 5 and 6 are
+	// added at the same time to test the tie breaker.
+	for i := 1; i <= 10; i++ {
+		htlc := testHtlc(uint64(i))
+		if i == 6 {
+			htlc.addTime = time.Unix(5, 0)
+		}
+		htlc.settled = i%2 == 0
+		htlc.incomingPeer, htlc.outgoingPeer = peer1, peer2
+		if i > 5 {
+			htlc.incomingPeer, htlc.outgoingPeer = peer2, peer1
+			htlc.incomingCircuit.channel = 3
+		}
+
+		require.NoError(t, db.RecordHtlcResolution(ctx, testNodeKey, htlc))
+	}
+
+	list := func(query *ForwardingHistoryQuery) []uint64 {
+		query.End = time.Unix(1000000, 0)
+
+		htlcs, err := db.ListForwardingHistory(ctx, testNodeKey, query)
+		require.NoError(t, err)
+
+		indices := make([]uint64, len(htlcs))
+		for i, htlc := range htlcs {
+			indices[i] = htlc.incomingCircuit.htlc
+		}
+
+		return indices
+	}
+
+	settled := true
+	require.Equal(t, []uint64{6, 8, 10}, list(&ForwardingHistoryQuery{
+		IncomingPeer: &peer2,
+		Settled:      &settled,
+	}))
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, list(&ForwardingHistoryQuery{
+		OutgoingPeer: &peer2,
+	}))
+	require.Equal(t, []uint64{10, 9, 8, 7, 6}, list(&ForwardingHistoryQuery{
+		Channel:    3,
+		Descending: true,
+	}))
+	require.Equal(t, []uint64{3, 4}, list(&ForwardingHistoryQuery{
+		Limit:  2,
+		Offset: 2,
+	}))
+
+	// Page through the history using cursors, in both directions.
+	for _, descending := range []bool{false, true} {
+		var (
+			after *fwdHistoryCursor
+			all   []uint64
+		)
+		for {
+			query := &ForwardingHistoryQuery{
+				Descending: descending,
+				Limit:      3,
+				After:      after,
+				End:        time.Unix(1000000, 0),
+			}
+			htlcs, err := db.ListForwardingHistory(
+				ctx, testNodeKey, query,
+			)
+			require.NoError(t, err)
+
+			for _, htlc := range htlcs {
+				all = append(all, htlc.incomingCircuit.htlc)
+			}
+
+			if len(htlcs) < query.Limit {
+				break
+			}
+
+			// Round trip the cursor through its encoding.
+			token := newFwdHistoryCursor(htlcs[len(htlcs)-1]).encode()
+			after, err = decodeFwdHistoryCursor(token)
+			require.NoError(t, err)
+		}
+
+		expected := []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+		if descending {
+			expected = []uint64{10, 9, 8, 7, 6, 5, 4, 3, 2, 1}
+		}
+		require.Equal(t, expected, all)
+	}
+
+	_, err := decodeFwdHistoryCursor("invalid")
+	require.Error(t, err)
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// historyWatermarksTableSchema is the schema of the table that keeps track of
+// the periods of the forwarding history of a node that are covered by the
+// rollups. Unlike the forwarding history itself, the watermarks aren't
+// affected by pruning. Nodes that recorded live forwards before the table was
+// added start out at their oldest remaining live forward.
+var historyWatermarksTableSchema = []string{
+	`CREATE TABLE IF NOT EXISTS history_watermarks (
+		node TEXT PRIMARY KEY NOT NULL,
+		first_live_time BIGINT,
+		pruned_until BIGINT
+	);`,
+	`INSERT INTO history_watermarks (node, first_live_time)
+		SELECT node, MIN(resolved_time) FROM forwarding_history
+		WHERE source = 'live' GROUP BY node;`,
+}
+
+// historyWatermarks are the watermarks of the forwarding history of a node.
+type historyWatermarks struct {
+	// firstLive is the resolve time of the first forward that was recorded
+	// live. From then on, the rollups are kept up to date by live
+	// recording.
+	firstLive sql.NullInt64
+
+	// prunedUntil is the latest resolve time of the forwards that were
+	// pruned from the forwarding history. The rollups may still include
+	// forwards up to this time, while their rows are gone.
+	prunedUntil sql.NullInt64
+}
+
+// covers returns whether the rollups may already include a forward resolved at
+// the time provided, in unix nanoseconds.
+func (w *historyWatermarks) covers(resolveTime int64) bool {
+	if w.firstLive.Valid && resolveTime >= w.firstLive.Int64 {
+		return true
+	}
+
+	return w.prunedUntil.Valid && resolveTime <= w.prunedUntil.Int64
+}
+
+// getHistoryWatermarks returns the watermarks of a node.
+func (d *Db) getHistoryWatermarks(ctx context.Context, tx *sql.Tx,
+	node route.Vertex) (*historyWatermarks, error) {
+
+	const query = `SELECT first_live_time, pruned_until
+		FROM history_watermarks WHERE node = ?;`
+
+	var watermarks historyWatermarks
+	err := tx.QueryRowContext(
+		ctx, d.rebind(query), hex.EncodeToString(node[:]),
+	).Scan(&watermarks.firstLive, &watermarks.prunedUntil)
+	switch {
+	case err == sql.ErrNoRows:
+		return &watermarks, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	return &watermarks, nil
+}
+
+// recordFirstLive lowers the first live watermark of a node to the resolve
+// time provided, in unix nanoseconds, if it isn't set yet or is later.
+func (d *Db) recordFirstLive(ctx context.Context, tx *sql.Tx,
+	node route.Vertex, resolveTime int64) error {
+
+	const upsert = `INSERT INTO history_watermarks (node, first_live_time)
+		VALUES (?, ?)
+		ON CONFLICT (node) DO UPDATE SET
+			first_live_time = excluded.first_live_time
+		WHERE history_watermarks.first_live_time IS NULL OR
+			history_watermarks.first_live_time >
+				excluded.first_live_time;`
+
+	_, err := tx.ExecContext(
+		ctx, d.rebind(upsert), hex.EncodeToString(node[:]), resolveTime,
+	)
+
+	return err
+}
+
+// deleteForwardingHistory deletes the forwarding history rows that match the
+// condition provided. In the same transaction, the pruned watermarks of the
+// affected nodes are raised to the latest resolve time of their deleted rows.
+// The number of deleted rows is returned.
+func (d *Db) deleteForwardingHistory(ctx context.Context, where string,
+	args ...interface{}) (int64, error) {
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	rows, err := tx.QueryContext(
+		ctx, d.rebind(`SELECT node, MAX(resolved_time)
+			FROM forwarding_history WHERE `+where+` GROUP BY node;`),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	prunedUntil := make(map[string]int64)
+	for rows.Next() {
+		var (
+			node         string
+			resolvedTime int64
+		)
+		if err := rows.Scan(&node, &resolvedTime); err != nil {
+			return 0, err
+		}
+
+		prunedUntil[node] = resolvedTime
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	rows.Close()
+
+	const upsert = `INSERT INTO history_watermarks (node, pruned_until)
+		VALUES (?, ?)
+		ON CONFLICT (node) DO UPDATE SET
+			pruned_until = excluded.pruned_until
+		WHERE history_watermarks.pruned_until IS NULL OR
+			history_watermarks.pruned_until < excluded.pruned_until;`
+
+	for node, resolvedTime := range prunedUntil {
+		_, err := tx.ExecContext(
+			ctx, d.rebind(upsert), node, resolvedTime,
+		)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	result, err := tx.ExecContext(
+		ctx, d.rebind(`DELETE FROM forwarding_history WHERE `+where+`;`),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return n, tx.Commit()
+}
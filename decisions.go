@@ -0,0 +1,335 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightningequipment/circuitbreaker/circuitbreakerrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// decisionBufferSize is the number of decisions that are buffered for a
+// subscriber. Decisions are dropped for subscribers that fall further behind.
+const decisionBufferSize = 1000
+
+type decisionType int
+
+const (
+	decisionForward decisionType = iota
+	decisionQueue
+	decisionReject
+	decisionBlock
+	decisionResolve
+)
+
+type rejectReason int
+
+const (
+	reasonNone rejectReason = iota
+	reasonPendingLimit
+	reasonRateLimit
+	reasonQueueNotEmpty
+
+	// reasonOperator is used for queued htlcs that were failed by an
+	// operator.
+	reasonOperator
+)
+
+// htlcDecision is a decision on an intercepted htlc, or the resolution of a
+// forwarded htlc.
+type htlcDecision struct {
+	time     time.Time
+	decision decisionType
+	reason   rejectReason
+
+	incomingPeer    route.Vertex
+	incomingCircuit circuitKey
+
+	// outgoingPeer is only known for resolutions. For decisions, only the
+	// channel of the outgoing circuit is known.
+	outgoingPeer    *route.Vertex
+	outgoingCircuit circuitKey
+
+	incomingMsat lnwire.MilliSatoshi
+	outgoingMsat lnwire.MilliSatoshi
+
+	queuePosition int
+	dequeued      bool
+	settled       bool
+
+	// operator is set for decisions on queued htlcs that were made by an
+	// operator.
+	operator bool
+
+	// queueWait is the time that a dequeued htlc spent in the queue.
+	queueWait time.Duration
+
+	// holdTime is the time that a resolved htlc was in flight. It is zero
+	// if the add time is unknown.
+	holdTime time.Duration
+}
+
+// decisionSubscription receives the decisions that are published after it was
+// created.
+type decisionSubscription struct {
+	decisions chan *htlcDecision
+
+	// missed is the number of decisions that were dropped since the last
+	// decision that was received.
+	missed uint64
+}
+
+// next returns the next decision and the number of decisions that were
+// dropped since the previous call.
+func (s *decisionSubscription) next(ctx context.Context) (*htlcDecision,
+	uint64, error) {
+
+	select {
+	case decision := <-s.decisions:
+		return decision, atomic.SwapUint64(&s.missed, 0), nil
+
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	}
+}
+
+// decisionBroker fans out htlc decisions to subscribers. Publishing never
+// blocks, so that slow subscribers can't hold up htlcs.
+type decisionBroker struct {
+	subscriptions map[*decisionSubscription]struct{}
+	lock          sync.Mutex
+}
+
+func newDecisionBroker() *decisionBroker {
+	return &decisionBroker{
+		subscriptions: make(map[*decisionSubscription]struct{}),
+	}
+}
+
+// subscribe adds a subscription. The function that is returned cancels it.
+func (b *decisionBroker) subscribe() (*decisionSubscription, func()) {
+	sub := &decisionSubscription{
+		decisions: make(chan *htlcDecision, decisionBufferSize),
+	}
+
+	b.lock.Lock()
+	b.subscriptions[sub] = struct{}{}
+	b.lock.Unlock()
+
+	return sub, func() {
+		b.lock.Lock()
+		delete(b.subscriptions, sub)
+		b.lock.Unlock()
+	}
+}
+
+// publish passes the decision on to all subscriptions that have room for it.
+func (b *decisionBroker) publish(decision *htlcDecision) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for sub := range b.subscriptions {
+		select {
+		case sub.decisions <- decision:
+
+		default:
+			atomic.AddUint64(&sub.missed, 1)
+		}
+	}
+}
+
+func marshalDecisionType(decision decisionType) (circuitbreakerrpc.DecisionType,
+	error) {
+
+	switch decision {
+	case decisionForward:
+		return circuitbreakerrpc.DecisionType_DECISION_TYPE_FORWARD, nil
+
+	case decisionQueue:
+		return circuitbreakerrpc.DecisionType_DECISION_TYPE_QUEUE, nil
+
+	case decisionReject:
+		return circuitbreakerrpc.DecisionType_DECISION_TYPE_REJECT, nil
+
+	case decisionBlock:
+		return circuitbreakerrpc.DecisionType_DECISION_TYPE_BLOCK, nil
+
+	case decisionResolve:
+		return circuitbreakerrpc.DecisionType_DECISION_TYPE_RESOLVE, nil
+
+	default:
+		return 0, fmt.Errorf("unknown decision type: %v", decision)
+	}
+}
+
+func marshalRejectReason(reason rejectReason) (circuitbreakerrpc.RejectReason,
+	error) {
+
+	switch reason {
+	case reasonNone:
+		return circuitbreakerrpc.RejectReason_REJECT_REASON_NONE, nil
+
+	case reasonPendingLimit:
+		return circuitbreakerrpc.RejectReason_REJECT_REASON_PENDING_LIMIT, nil
+
+	case reasonRateLimit:
+		return circuitbreakerrpc.RejectReason_REJECT_REASON_RATE_LIMIT, nil
+
+	case reasonQueueNotEmpty:
+		return circuitbreakerrpc.RejectReason_REJECT_REASON_QUEUE_NOT_EMPTY, nil
+
+	case reasonOperator:
+		return circuitbreakerrpc.RejectReason_REJECT_REASON_OPERATOR, nil
+
+	default:
+		return 0, fmt.Errorf("unknown reject reason: %v", reason)
+	}
+}
+
+func marshalHtlcDecision(decision *htlcDecision,
+	missed uint64) (*circuitbreakerrpc.HtlcDecision, error) {
+
+	decisionType, err := marshalDecisionType(decision.decision)
+	if err != nil {
+		return nil, err
+	}
+
+	reason, err := marshalRejectReason(decision.reason)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcDecision := &circuitbreakerrpc.HtlcDecision{
+		TimeNs:       uint64(decision.time.UnixNano()),
+		Type:         decisionType,
+		Reason:       reason,
+		IncomingPeer: decision.incomingPeer.String(),
+		IncomingCircuit: &circuitbreakerrpc.CircuitKey{
+			ShortChannelId: decision.incomingCircuit.channel,
+			HtlcIndex:      uint32(decision.incomingCircuit.htlc),
+		},
+		OutgoingCircuit: &circuitbreakerrpc.CircuitKey{
+			ShortChannelId: decision.outgoingCircuit.channel,
+			HtlcIndex:      uint32(decision.outgoingCircuit.htlc),
+		},
+		IncomingAmount: uint64(decision.incomingMsat),
+		OutgoingAmount: uint64(decision.outgoingMsat),
+		QueuePosition:  uint32(decision.queuePosition),
+		Dequeued:       decision.dequeued,
+		Settled:        decision.settled,
+		Operator:       decision.operator,
+		Missed:         missed,
+	}
+
+	if decision.outgoingPeer != nil {
+		rpcDecision.OutgoingPeer = decision.outgoingPeer.String()
+	}
+
+	return rpcDecision, nil
+}
+
+// subscribeHtlcDecisions passes the decisions of a node to the callback
+// provided until the context is cancelled or the callback fails.
+func (s *server) subscribeHtlcDecisions(ctx context.Context, nodeKey string,
+	cb func(*circuitbreakerrpc.HtlcDecision) error) error {
+
+	node, err := s.getNode(nodeKey)
+	if err != nil {
+		return err
+	}
+
+	sub, cancel := node.process.decisions.subscribe()
+	defer cancel()
+
+	for {
+		decision, missed, err := sub.next(ctx)
+		if err != nil {
+			return err
+		}
+
+		rpcDecision, err := marshalHtlcDecision(decision, missed)
+		if err != nil {
+			return err
+		}
+
+		if err := cb(rpcDecision); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *server) SubscribeHtlcDecisions(
+	req *circuitbreakerrpc.SubscribeHtlcDecisionsRequest,
+	stream circuitbreakerrpc.Service_SubscribeHtlcDecisionsServer) error {
+
+	return s.subscribeHtlcDecisions(
+		stream.Context(), req.NodeKey,
+		func(decision *circuitbreakerrpc.HtlcDecision) error {
+			return stream.Send(decision)
+		},
+	)
+}
+
+// newDecisionsHandler returns the http handler that streams htlc decisions as
+// server-sent events. The optional query parameter node selects the lnd node.
+func newDecisionsHandler(s *server) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed",
+				http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming not supported",
+				http.StatusInternalServerError)
+
+			return
+		}
+
+		if _, err := s.getNode(r.URL.Query().Get("node")); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		err := s.subscribeHtlcDecisions(
+			r.Context(), r.URL.Query().Get("node"),
+			func(decision *circuitbreakerrpc.HtlcDecision) error {
+				// Emit zero values, so that forwards have a
+				// type.
+				data, err := protojson.MarshalOptions{
+					EmitUnpopulated: true,
+				}.Marshal(decision)
+				if err != nil {
+					return err
+				}
+
+				_, err = fmt.Fprintf(w, "data: %s\n\n", data)
+				if err != nil {
+					return err
+				}
+				flusher.Flush()
+
+				return nil
+			},
+		)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			s.log.Infow("Htlc decisions stream ended", "err", err)
+		}
+	})
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightningequipment/circuitbreaker/circuitbreakerrpc"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestHtlcDecisions(t *testing.T) {
+	defer Timeout()()
+
+	client := newLndclientMock(testChannels, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db, cleanup := setupTestDb(t, defaultFwdHistoryLimit)
+	defer cleanup()
+
+	log := zaptest.NewLogger(t).Sugar()
+
+	cfg := &Limits{
+		PerPeer: map[route.Vertex]Limit{
+			{2}: {
+				MaxPending: 1,
+			},
+			{3}: {
+				Mode: ModeBlock,
+			},
+		},
+	}
+
+	p := NewProcess(client, log, cfg, db)
+
+	sub, cancelSub := p.decisions.subscribe()
+	defer cancelSub()
+
+	exit := make(chan error)
+	go func() {
+		exit <- p.Run(ctx)
+	}()
+
+	intercept := func(key circuitKey, expectResume bool) {
+		client.htlcInterceptorRequests <- &interceptedEvent{
+			circuitKey:      key,
+			incomingMsat:    1010,
+			outgoingMsat:    1000,
+			outgoingChannel: outgoingKey.channel,
+		}
+
+		resp := <-client.htlcInterceptorResponses
+		require.Equal(t, expectResume, resp.resume)
+	}
+
+	next := func() *htlcDecision {
+		decision, missed, err := sub.next(ctx)
+		require.NoError(t, err)
+		require.Zero(t, missed)
+
+		return decision
+	}
+
+	// The first htlc is forwarded.
+	key := circuitKey{channel: 2, htlc: 5}
+	intercept(key, true)
+
+	decision := next()
+	require.Equal(t, decisionForward, decision.decision)
+	require.Equal(t, route.Vertex{2}, decision.incomingPeer)
+	require.Equal(t, key, decision.incomingCircuit)
+	require.Equal(t, outgoingKey.channel, decision.outgoingCircuit.channel)
+	require.EqualValues(t, 1010, decision.incomingMsat)
+	require.EqualValues(t, 1000, decision.outgoingMsat)
+
+	// The second htlc exceeds the pending limit.
+	intercept(circuitKey{channel: 2, htlc: 6}, false)
+
+	decision = next()
+	require.Equal(t, decisionReject, decision.decision)
+	require.Equal(t, reasonPendingLimit, decision.reason)
+
+	// Htlcs from the blocked peer are failed.
+	intercept(circuitKey{channel: 3, htlc: 1}, false)
+
+	decision = next()
+	require.Equal(t, decisionBlock, decision.decision)
+	require.Equal(t, route.Vertex{3}, decision.incomingPeer)
+
+	// The resolution of the forwarded htlc is reported.
+	client.htlcEvents <- &resolvedEvent{
+		incomingCircuitKey: key,
+		outgoingCircuitKey: outgoingKey,
+		settled:            true,
+	}
+
+	decision = next()
+	require.Equal(t, decisionResolve, decision.decision)
+	require.True(t, decision.settled)
+	require.Equal(t, key, decision.incomingCircuit)
+	require.Equal(t, outgoingKey, decision.outgoingCircuit)
+	require.Equal(t, &route.Vertex{4}, decision.outgoingPeer)
+	require.EqualValues(t, 1010, decision.incomingMsat)
+
+	cancel()
+	require.ErrorIs(t, <-exit, context.Canceled)
+}
+
+func TestDecisionBroker(t *testing.T) {
+	ctx := context.Background()
+	broker := newDecisionBroker()
+
+	sub, cancel := broker.subscribe()
+
+	// Publishing doesn't block when the subscriber falls behind.
+	for i := 0; i < decisionBufferSize+2; i++ {
+		broker.publish(&htlcDecision{
+			incomingCircuit: circuitKey{htlc: uint64(i)},
+		})
+	}
+
+	decision, missed, err := sub.next(ctx)
+	require.NoError(t, err)
+	require.Zero(t, decision.incomingCircuit.htlc)
+	require.EqualValues(t, 2, missed)
+
+	for i := 1; i < decisionBufferSize; i++ {
+		decision, missed, err := sub.next(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, i, decision.incomingCircuit.htlc)
+		require.Zero(t, missed)
+	}
+
+	// Cancelled subscriptions no longer receive decisions.
+	cancel()
+	broker.publish(&htlcDecision{})
+	require.Empty(t, sub.decisions)
+}
+
+func TestMarshalHtlcDecision(t *testing.T) {
+	decision := &htlcDecision{
+		decision: decisionReject,
+		reason:   reasonRateLimit,
+	}
+
+	rpcDecision, err := marshalHtlcDecision(decision, 2)
+	require.NoError(t, err)
+	require.Equal(t, circuitbreakerrpc.DecisionType_DECISION_TYPE_REJECT,
+		rpcDecision.Type)
+	require.Equal(t, circuitbreakerrpc.RejectReason_REJECT_REASON_RATE_LIMIT,
+		rpcDecision.Reason)
+	require.EqualValues(t, 2, rpcDecision.Missed)
+
+	// Unknown values are reported as errors rather than crashing the
+	// subscriber.
+	_, err = marshalHtlcDecision(&htlcDecision{decision: 99}, 0)
+	require.ErrorContains(t, err, "unknown decision type")
+
+	_, err = marshalHtlcDecision(
+		&htlcDecision{decision: decisionReject, reason: 99}, 0,
+	)
+	require.ErrorContains(t, err, "unknown reject reason")
+}
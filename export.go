@@ -0,0 +1,337 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/lightningequipment/circuitbreaker/circuitbreakerrpc"
+)
+
+// exportPageSize is the number of forwards that are read from the database at
+// a time while exporting, so that the full history is never held in memory.
+const exportPageSize = 1000
+
+// exportedForward is a forward with derived fields.
+type exportedForward struct {
+	htlc *HtlcInfo
+
+	fee      int64
+	holdTime time.Duration
+
+	incomingAlias string
+	outgoingAlias string
+}
+
+// exportForwardingHistory reads the forwarding history of a node page by page
+// and passes every forward to the callback provided.
+func (s *server) exportForwardingHistory(ctx context.Context, node *lndNode,
+	start, end time.Time, cb func(*exportedForward) error) error {
+
+	query := &ForwardingHistoryQuery{
+		Start: start,
+		End:   end,
+		Limit: exportPageSize,
+	}
+
+	for {
+		htlcs, err := s.db.ListForwardingHistory(ctx, node.key, query)
+		if err != nil {
+			return err
+		}
+
+		for _, htlc := range htlcs {
+			incomingAlias, err := s.getAlias(node, htlc.incomingPeer)
+			if err != nil {
+				return err
+			}
+
+			outgoingAlias, err := s.getAlias(node, htlc.outgoingPeer)
+			if err != nil {
+				return err
+			}
+
+			holdTime, _ := htlc.holdTime()
+
+			err = cb(&exportedForward{
+				htlc: htlc,
+				fee: int64(htlc.incomingMsat) -
+					int64(htlc.outgoingMsat),
+				holdTime:      holdTime,
+				incomingAlias: incomingAlias,
+				outgoingAlias: outgoingAlias,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(htlcs) < query.Limit {
+			return nil
+		}
+
+		query.After = newFwdHistoryCursor(htlcs[len(htlcs)-1])
+	}
+}
+
+func (s *server) ExportForwardingHistory(
+	req *circuitbreakerrpc.ExportForwardingHistoryRequest,
+	stream circuitbreakerrpc.Service_ExportForwardingHistoryServer) error {
+
+	node, err := s.getNode(req.NodeKey)
+	if err != nil {
+		return err
+	}
+
+	start, end, err := parseTimeRange(req.AddStartTimeNs, req.AddEndTimeNs)
+	if err != nil {
+		return err
+	}
+
+	return s.exportForwardingHistory(
+		stream.Context(), node, start, end,
+		func(fwd *exportedForward) error {
+			var fee uint64
+			if fwd.fee > 0 {
+				fee = uint64(fwd.fee)
+			}
+
+			return stream.Send(&circuitbreakerrpc.ExportedForward{
+				Forward:           s.marshalFwdHistory([]*HtlcInfo{fwd.htlc})[0],
+				FeeMsat:           fee,
+				HoldTimeNs:        uint64(fwd.holdTime),
+				IncomingPeerAlias: fwd.incomingAlias,
+				OutgoingPeerAlias: fwd.outgoingAlias,
+			})
+		},
+	)
+}
+
+// exportRecord is a single row of the csv and json lines exports.
+type exportRecord struct {
+	AddTime           string `json:"add_time"`
+	ResolveTime       string `json:"resolve_time"`
+	Settled           bool   `json:"settled"`
+	IncomingPeer      string `json:"incoming_peer"`
+	IncomingPeerAlias string `json:"incoming_peer_alias"`
+	IncomingChannel   uint64 `json:"incoming_channel"`
+	IncomingHtlcIndex uint64 `json:"incoming_htlc_index"`
+	OutgoingPeer      string `json:"outgoing_peer"`
+	OutgoingPeerAlias string `json:"outgoing_peer_alias"`
+	OutgoingChannel   uint64 `json:"outgoing_channel"`
+	OutgoingHtlcIndex uint64 `json:"outgoing_htlc_index"`
+	IncomingAmtMsat   uint64 `json:"incoming_amt_msat"`
+	OutgoingAmtMsat   uint64 `json:"outgoing_amt_msat"`
+	FeeMsat           int64  `json:"fee_msat"`
+	Source            string `json:"source"`
+
+	// HoldDurationMs is nil if the add time is unknown.
+	HoldDurationMs *int64 `json:"hold_duration_ms"`
+}
+
+var exportCsvHeader = []string{
+	"add_time", "resolve_time", "settled", "incoming_peer",
+	"incoming_peer_alias", "incoming_channel", "incoming_htlc_index",
+	"outgoing_peer", "outgoing_peer_alias", "outgoing_channel",
+	"outgoing_htlc_index", "incoming_amt_msat", "outgoing_amt_msat",
+	"fee_msat", "hold_duration_ms", "source",
+}
+
+func newExportRecord(fwd *exportedForward) *exportRecord {
+	htlc := fwd.htlc
+	incomingIndex, outgoingIndex := htlc.htlcIndices()
+
+	record := &exportRecord{
+		ResolveTime:       htlc.resolveTime.UTC().Format(time.RFC3339Nano),
+		Settled:           htlc.settled,
+		IncomingPeer:      htlc.incomingPeer.String(),
+		IncomingPeerAlias: fwd.incomingAlias,
+		IncomingChannel:   htlc.incomingCircuit.channel,
+		IncomingHtlcIndex: incomingIndex,
+		OutgoingPeer:      htlc.outgoingPeer.String(),
+		OutgoingPeerAlias: fwd.outgoingAlias,
+		OutgoingChannel:   htlc.outgoingCircuit.channel,
+		OutgoingHtlcIndex: outgoingIndex,
+		IncomingAmtMsat:   uint64(htlc.incomingMsat),
+		OutgoingAmtMsat:   uint64(htlc.outgoingMsat),
+		FeeMsat:           fwd.fee,
+		Source:            string(htlc.source),
+	}
+
+	if _, ok := htlc.holdTime(); ok {
+		record.AddTime = htlc.addTime.UTC().Format(time.RFC3339Nano)
+
+		holdMs := fwd.holdTime.Milliseconds()
+		record.HoldDurationMs = &holdMs
+	}
+
+	return record
+}
+
+func (r *exportRecord) csv() []string {
+	var holdMs string
+	if r.HoldDurationMs != nil {
+		holdMs = strconv.FormatInt(*r.HoldDurationMs, 10)
+	}
+
+	return []string{
+		r.AddTime, r.ResolveTime, strconv.FormatBool(r.Settled),
+		r.IncomingPeer, r.IncomingPeerAlias,
+		strconv.FormatUint(r.IncomingChannel, 10),
+		strconv.FormatUint(r.IncomingHtlcIndex, 10),
+		r.OutgoingPeer, r.OutgoingPeerAlias,
+		strconv.FormatUint(r.OutgoingChannel, 10),
+		strconv.FormatUint(r.OutgoingHtlcIndex, 10),
+		strconv.FormatUint(r.IncomingAmtMsat, 10),
+		strconv.FormatUint(r.OutgoingAmtMsat, 10),
+		strconv.FormatInt(r.FeeMsat, 10), holdMs, r.Source,
+	}
+}
+
+// newExportHandler returns the http handler that streams the forwarding
+// history as csv or json lines. The query parameters are format (csv or
+// jsonl), node, start_time_ns and end_time_ns.
+func newExportHandler(s *server) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed",
+				http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		if err := s.serveExport(w, r); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	})
+}
+
+// exportWriter keeps track of whether anything has been written to the
+// response.
+type exportWriter struct {
+	http.ResponseWriter
+
+	written bool
+}
+
+func (e *exportWriter) Write(b []byte) (int, error) {
+	e.written = true
+
+	return e.ResponseWriter.Write(b)
+}
+
+// serveExport writes the export to the response. Invalid requests are returned
+// as errors. Failures of the export itself are reported with an internal
+// server error as long as nothing has been written, and abort the response
+// once streaming has started.
+func (s *server) serveExport(rw http.ResponseWriter, r *http.Request) error {
+	params := r.URL.Query()
+
+	node, err := s.getNode(params.Get("node"))
+	if err != nil {
+		return err
+	}
+
+	var startNs, endNs int64
+	if str := params.Get("start_time_ns"); str != "" {
+		startNs, err = strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid start_time_ns: %w", err)
+		}
+	}
+	if str := params.Get("end_time_ns"); str != "" {
+		endNs, err = strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid end_time_ns: %w", err)
+		}
+	}
+
+	start, end, err := parseTimeRange(startNs, endNs)
+	if err != nil {
+		return err
+	}
+
+	format := params.Get("format")
+	if format == "" {
+		format = "csv"
+	}
+
+	w := &exportWriter{ResponseWriter: rw}
+
+	var (
+		write func(*exportRecord) error
+		flush func() error
+	)
+
+	switch format {
+	case "csv":
+		csvWriter := csv.NewWriter(w)
+		if err := csvWriter.Write(exportCsvHeader); err != nil {
+			return err
+		}
+
+		write = func(record *exportRecord) error {
+			return csvWriter.Write(record.csv())
+		}
+		flush = func() error {
+			csvWriter.Flush()
+
+			return csvWriter.Error()
+		}
+
+		w.Header().Set("Content-Type", "text/csv")
+
+	case "jsonl":
+		encoder := json.NewEncoder(w)
+
+		write = func(record *exportRecord) error {
+			return encoder.Encode(record)
+		}
+		flush = func() error {
+			return nil
+		}
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+
+	default:
+		return fmt.Errorf("unknown format %v", format)
+	}
+
+	w.Header().Set(
+		"Content-Disposition", fmt.Sprintf(
+			"attachment; filename=forwarding_history_%v.%v",
+			node.key.String()[:8], format,
+		),
+	)
+
+	err = s.exportForwardingHistory(
+		r.Context(), node, start, end, func(fwd *exportedForward) error {
+			return write(newExportRecord(fwd))
+		},
+	)
+	if err == nil {
+		err = flush()
+	}
+	if err != nil {
+		s.log.Errorw("Error exporting forwarding history", "err", err)
+
+		// The csv writer buffers its output, so the export may fail
+		// before anything has been sent.
+		if !w.written {
+			w.Header().Del("Content-Disposition")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return nil
+		}
+
+		// The status has already been sent, so the best we can do is
+		// to cut the response short.
+		panic(http.ErrAbortHandler)
+	}
+
+	return nil
+}
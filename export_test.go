@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestExport(t *testing.T) {
+	ctx := context.Background()
+
+	db, cleanup := setupTestDb(t, 10)
+	defer cleanup()
+
+	require.NoError(t, db.InitNode(ctx, mockIdentity, false))
+
+	for i := uint64(1); i <= 3; i++ {
+		htlc := testHtlc(i)
+		htlc.resolveTime = htlc.addTime.Add(1500 * time.Millisecond)
+
+		require.NoError(t, db.RecordHtlcResolution(ctx, mockIdentity, htlc))
+	}
+
+	lnd := newLndclientMock(testChannels, nil)
+	node := newLndNode(mockIdentity, lnd, nil)
+	handler := newExportHandler(
+		NewServer(zap.NewNop().Sugar(), []*lndNode{node}, db),
+	)
+
+	export := func(query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/export"+query, nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	// Csv is the default format.
+	rec := export("")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "text/csv", rec.Header().Get("Content-Type"))
+
+	rows, err := csv.NewReader(rec.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+	require.Equal(t, exportCsvHeader, rows[0])
+
+	record := rows[1]
+	require.Equal(t, "1970-01-01T00:00:01Z", record[0])
+	require.Equal(t, "alias-"+record[3][:6], record[4])
+	require.Equal(t, "5", record[13])
+	require.Equal(t, "1500", record[14])
+
+	// Json lines.
+	rec = export("?format=jsonl&start_time_ns=2000000000")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var records []*exportRecord
+	scanner := bufio.NewScanner(rec.Body)
+	for scanner.Scan() {
+		var record exportRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+
+		records = append(records, &record)
+	}
+	require.Len(t, records, 2)
+	require.Equal(t, uint64(2), records[0].IncomingHtlcIndex)
+	require.Equal(t, int64(5), records[0].FeeMsat)
+	require.Equal(t, int64(1500), *records[0].HoldDurationMs)
+
+	// Invalid requests are rejected before anything is written.
+	require.Equal(t, http.StatusBadRequest, export("?format=xml").Code)
+	require.Equal(t, http.StatusBadRequest,
+		export("?start_time_ns=2&end_time_ns=1").Code)
+	require.Equal(t, http.StatusBadRequest, export("?node=00").Code)
+
+	// A failing export is reported properly if nothing was sent yet.
+	require.NoError(t, db.Close())
+
+	rec = export("")
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	require.Empty(t, rec.Header().Get("Content-Disposition"))
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/lightningequipment/circuitbreaker/circuitbreakerrpc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// healthCheckTimeout is the time that the health checks wait for the
+	// event loops and the database to respond.
+	healthCheckTimeout = time.Second
+
+	// grpcHealthInterval is the interval at which the status of the grpc
+	// health service is refreshed.
+	grpcHealthInterval = 5 * time.Second
+)
+
+// grpcHealthServices are the services that are reported by the grpc health
+// service. The empty name stands for the server as a whole.
+var grpcHealthServices = []string{
+	"", circuitbreakerrpc.Service_ServiceDesc.ServiceName,
+}
+
+// nodeHealth is the health of the process of a single lnd node.
+type nodeHealth struct {
+	Node                string `json:"node"`
+	Connected           bool   `json:"connected"`
+	HtlcEventsActive    bool   `json:"htlc_events_active"`
+	InterceptorActive   bool   `json:"interceptor_active"`
+	EventLoopResponsive bool   `json:"event_loop_responsive"`
+	ShuttingDown        bool   `json:"shutting_down"`
+
+	// LastLndEventSecs is the time in seconds since the last event was
+	// received from lnd. It is omitted if no event was received yet.
+	LastLndEventSecs *float64 `json:"last_lnd_event_secs,omitempty"`
+}
+
+func (n *nodeHealth) ready() bool {
+	return n.Connected && n.HtlcEventsActive && n.InterceptorActive &&
+		n.EventLoopResponsive && !n.ShuttingDown
+}
+
+// healthStatus is the outcome of the health checks.
+type healthStatus struct {
+	// Live indicates that the event loops of all running processes are
+	// responsive. Processes that are still connecting to lnd don't affect
+	// liveness.
+	Live bool `json:"live"`
+
+	// Ready indicates that all processes are connected to lnd with both
+	// streams active and a responsive event loop and aren't shutting down,
+	// and that the database is writable.
+	Ready bool `json:"ready"`
+
+	DbWritable bool   `json:"db_writable"`
+	DbError    string `json:"db_error,omitempty"`
+
+	Nodes []*nodeHealth `json:"nodes"`
+}
+
+// healthChecker checks the health of circuitbreaker for the liveness and
+// readiness probes and the grpc health service.
+type healthChecker struct {
+	log   *zap.SugaredLogger
+	nodes []*lndNode
+	db    Store
+}
+
+func newHealthChecker(log *zap.SugaredLogger, nodes []*lndNode,
+	db Store) *healthChecker {
+
+	return &healthChecker{
+		log:   log,
+		nodes: nodes,
+		db:    db,
+	}
+}
+
+// check runs the health checks.
+func (h *healthChecker) check(ctx context.Context) *healthStatus {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	status := &healthStatus{
+		Live:  true,
+		Nodes: make([]*nodeHealth, 0, len(h.nodes)),
+	}
+
+	now := time.Now()
+	for _, node := range h.nodes {
+		lnd := node.process.getLndHealth()
+
+		nodeStatus := &nodeHealth{
+			Node:              node.key.String(),
+			Connected:         lnd.connected,
+			HtlcEventsActive:  lnd.htlcEventsActive,
+			InterceptorActive: lnd.interceptorActive,
+			ShuttingDown:      lnd.shuttingDown,
+		}
+
+		if !lnd.lastEvent.IsZero() {
+			secs := now.Sub(lnd.lastEvent).Seconds()
+			nodeStatus.LastLndEventSecs = &secs
+		}
+
+		// The event loop is only started once both streams are set up.
+		if lnd.connected && lnd.htlcEventsActive &&
+			lnd.interceptorActive {
+
+			err := node.process.pingEventLoop(ctx)
+			nodeStatus.EventLoopResponsive = err == nil
+			if err != nil {
+				status.Live = false
+			}
+		}
+
+		status.Nodes = append(status.Nodes, nodeStatus)
+	}
+
+	err := h.db.CheckWritable(ctx)
+	if err != nil {
+		status.DbError = err.Error()
+	}
+	status.DbWritable = err == nil
+
+	status.Ready = status.Live && status.DbWritable
+	for _, nodeStatus := range status.Nodes {
+		if !nodeStatus.ready() {
+			status.Ready = false
+		}
+	}
+
+	return status
+}
+
+// newHealthHandler returns the handler of the liveness or the readiness probe.
+// The status is always returned as json, with status code 503 if the probe
+// fails.
+func newHealthHandler(checker *healthChecker, readiness bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := checker.check(r.Context())
+
+		ok := status.Live
+		if readiness {
+			ok = status.Ready
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if !ok {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(status)
+	})
+}
+
+// runGrpcHealth keeps the grpc health service up to date with the readiness of
+// circuitbreaker until the context is cancelled.
+func (h *healthChecker) runGrpcHealth(ctx context.Context,
+	server *health.Server, interval time.Duration) error {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var ready *bool
+	for {
+		status := h.check(ctx)
+
+		// Only log changes, the probes are polled continuously.
+		if ready == nil || *ready != status.Ready {
+			h.log.Infow("Readiness changed", "ready", status.Ready)
+		}
+		ready = &status.Ready
+
+		servingStatus := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		if status.Ready {
+			servingStatus = grpc_health_v1.HealthCheckResponse_SERVING
+		}
+		for _, service := range grpcHealthServices {
+			server.SetServingStatus(service, servingStatus)
+		}
+
+		select {
+		case <-ticker.C:
+
+		case <-ctx.Done():
+			server.Shutdown()
+
+			return nil
+		}
+	}
+}
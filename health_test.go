@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestHealth(t *testing.T) {
+	defer Timeout()()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db, cleanup := setupTestDb(t, defaultFwdHistoryLimit)
+	defer cleanup()
+
+	client := newLndclientMock(testChannels, nil)
+	log := zaptest.NewLogger(t).Sugar()
+	p := NewProcess(client, log, &Limits{}, db)
+
+	checker := newHealthChecker(
+		log, []*lndNode{newLndNode(mockIdentity, client, p)}, db,
+	)
+
+	// probe calls the http handler of a probe and returns the status code
+	// and the decoded status.
+	probe := func(readiness bool) (int, *healthStatus) {
+		rec := httptest.NewRecorder()
+		newHealthHandler(checker, readiness).ServeHTTP(
+			rec, httptest.NewRequest(http.MethodGet, "/", nil),
+		)
+
+		var status healthStatus
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&status))
+
+		return rec.Code, &status
+	}
+
+	// Before the process has connected, it is live but not ready.
+	code, status := probe(false)
+	require.Equal(t, http.StatusOK, code)
+	require.True(t, status.Live)
+	require.True(t, status.DbWritable)
+
+	code, status = probe(true)
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.False(t, status.Ready)
+	require.Len(t, status.Nodes, 1)
+	require.Equal(t, mockIdentity.String(), status.Nodes[0].Node)
+	require.False(t, status.Nodes[0].Connected)
+	require.Nil(t, status.Nodes[0].LastLndEventSecs)
+
+	healthServer := health.NewServer()
+	grpcCtx, cancelGrpc := context.WithCancel(ctx)
+	grpcExit := make(chan error)
+	go func() {
+		grpcExit <- checker.runGrpcHealth(
+			grpcCtx, healthServer, 10*time.Millisecond,
+		)
+	}()
+
+	exit := make(chan error)
+	go func() {
+		exit <- p.Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		return checker.check(ctx).Ready
+	}, time.Second, 10*time.Millisecond)
+
+	code, status = probe(true)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, &nodeHealth{
+		Node:                mockIdentity.String(),
+		Connected:           true,
+		HtlcEventsActive:    true,
+		InterceptorActive:   true,
+		EventLoopResponsive: true,
+	}, status.Nodes[0])
+
+	for _, service := range grpcHealthServices {
+		require.Eventually(t, func() bool {
+			resp, err := healthServer.Check(
+				ctx, &grpc_health_v1.HealthCheckRequest{
+					Service: service,
+				},
+			)
+			require.NoError(t, err)
+
+			return resp.Status ==
+				grpc_health_v1.HealthCheckResponse_SERVING
+		}, time.Second, 10*time.Millisecond)
+	}
+
+	// Events received from lnd are reflected in the status.
+	client.htlcInterceptorRequests <- &interceptedEvent{
+		circuitKey:      circuitKey{channel: 2, htlc: 1},
+		incomingMsat:    lnwire.MilliSatoshi(2000),
+		outgoingMsat:    lnwire.MilliSatoshi(1000),
+		outgoingChannel: 4,
+	}
+	<-client.htlcInterceptorResponses
+
+	_, status = probe(true)
+	require.NotNil(t, status.Nodes[0].LastLndEventSecs)
+	require.GreaterOrEqual(t, *status.Nodes[0].LastLndEventSecs, 0.0)
+
+	// Once the process has stopped, it isn't ready anymore.
+	cancel()
+	require.ErrorIs(t, <-exit, context.Canceled)
+
+	status = checker.check(context.Background())
+	require.False(t, status.Ready)
+	require.False(t, status.Nodes[0].Connected)
+	require.False(t, status.Nodes[0].InterceptorActive)
+
+	cancelGrpc()
+	require.NoError(t, <-grpcExit)
+
+	resp, err := healthServer.Check(
+		context.Background(), &grpc_health_v1.HealthCheckRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+		resp.Status)
+}
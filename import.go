@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightningequipment/circuitbreaker/circuitbreakerrpc"
+)
+
+// importPageSize is the number of forwarding events that are requested from
+// lnd and imported at a time.
+const importPageSize = 1000
+
+func (s *server) ImportForwardingHistory(ctx context.Context,
+	req *circuitbreakerrpc.ImportForwardingHistoryRequest) (
+	*circuitbreakerrpc.ImportForwardingHistoryResponse, error) {
+
+	node, err := s.getNode(req.NodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end, err := parseTimeRange(req.StartTimeNs, req.EndTimeNs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Lnd's forwarding log only contains channel ids, so look up the peers
+	// of both open and closed channels.
+	channels, err := node.lnd.listChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	closedChannels, err := node.lnd.listClosedChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make(map[uint64]*channel, len(channels)+len(closedChannels))
+	for id, ch := range closedChannels {
+		peers[id] = ch
+	}
+	for id, ch := range channels {
+		peers[id] = ch
+	}
+
+	var (
+		offset            uint32
+		imported, skipped int
+
+		// lastTimestamp and ordinal track the position of a forward
+		// among the forwards with the same timestamp in lnd's log.
+		lastTimestamp time.Time
+		ordinal       uint64
+	)
+	for {
+		events, nextOffset, err := node.lnd.forwardingHistory(
+			start, end, offset, importPageSize,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		htlcs := make([]*HtlcInfo, 0, len(events))
+		for _, event := range events {
+			// Lnd doesn't log htlc indices. To still satisfy the
+			// unique circuit constraints, the timestamp is used
+			// instead, plus the position of the forward among the
+			// forwards with the same timestamp. Older versions of
+			// lnd only report timestamps in seconds, which makes
+			// forwards in the same second share a timestamp. Lnd's
+			// offsets are relative to the requested time range,
+			// which would make repeated imports of overlapping
+			// ranges produce different indices. The timestamp and
+			// position are stable, so that repeated imports skip
+			// forwards that were imported before.
+			if event.timestamp.Equal(lastTimestamp) {
+				ordinal++
+			} else {
+				lastTimestamp = event.timestamp
+				ordinal = 0
+			}
+			index := uint64(event.timestamp.UnixNano()) + ordinal
+
+			incoming, ok := peers[event.incomingChannel]
+			if !ok {
+				skipped++
+
+				continue
+			}
+
+			outgoing, ok := peers[event.outgoingChannel]
+			if !ok {
+				skipped++
+
+				continue
+			}
+
+			htlcs = append(htlcs, &HtlcInfo{
+				source:       sourceLnd,
+				addTime:      event.timestamp,
+				resolveTime:  event.timestamp,
+				settled:      true,
+				incomingMsat: event.incomingMsat,
+				outgoingMsat: event.outgoingMsat,
+				incomingPeer: incoming.peer,
+				outgoingPeer: outgoing.peer,
+				incomingCircuit: circuitKey{
+					channel: event.incomingChannel,
+					htlc:    index,
+				},
+				outgoingCircuit: circuitKey{
+					channel: event.outgoingChannel,
+					htlc:    index,
+				},
+			})
+		}
+
+		count, err := s.db.ImportForwardingHistory(ctx, node.key, htlcs)
+		if err != nil {
+			return nil, err
+		}
+
+		imported += count
+		skipped += len(htlcs) - count
+
+		if len(events) < importPageSize {
+			break
+		}
+
+		offset = nextOffset
+	}
+
+	s.log.Infow("Imported forwarding history from lnd",
+		"node", node.key, "imported", imported, "skipped", skipped)
+
+	return &circuitbreakerrpc.ImportForwardingHistoryResponse{
+		Imported: uint64(imported),
+		Skipped:  uint64(skipped),
+	}, nil
+}
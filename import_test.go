@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightningequipment/circuitbreaker/circuitbreakerrpc"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+func TestImportForwardingHistory(t *testing.T) {
+	ctx := context.Background()
+
+	db, cleanup := setupTestDb(t, 100)
+	defer cleanup()
+
+	require.NoError(t, db.InitNode(ctx, mockIdentity, false))
+
+	lnd := newLndclientMock(testChannels, nil)
+	for i := 0; i < importPageSize+5; i++ {
+		lnd.forwardingEvents = append(lnd.forwardingEvents, &forwardingEvent{
+			timestamp:       time.Unix(100, int64(i)),
+			incomingChannel: 2,
+			outgoingChannel: 4,
+			incomingMsat:    1010,
+			outgoingMsat:    1000,
+		})
+	}
+
+	// A forward over a channel that is unknown can't be imported.
+	lnd.forwardingEvents = append(lnd.forwardingEvents, &forwardingEvent{
+		timestamp:       time.Unix(200, 0),
+		incomingChannel: 99,
+		outgoingChannel: 4,
+		incomingMsat:    1010,
+		outgoingMsat:    1000,
+	})
+
+	// Older versions of lnd only report timestamps in seconds, so that
+	// forwards in the same second share a timestamp.
+	for i := 0; i < 3; i++ {
+		lnd.forwardingEvents = append(
+			lnd.forwardingEvents, &forwardingEvent{
+				timestamp:       time.Unix(250, 0),
+				incomingChannel: 2,
+				outgoingChannel: 4,
+				incomingMsat:    1010,
+				outgoingMsat:    1000,
+			},
+		)
+	}
+
+	// Forwards after the first live forward are covered by the live
+	// history.
+	lnd.forwardingEvents = append(lnd.forwardingEvents, &forwardingEvent{
+		timestamp:       time.Unix(400, 0),
+		incomingChannel: 2,
+		outgoingChannel: 4,
+		incomingMsat:    1010,
+		outgoingMsat:    1000,
+	})
+
+	live := testHtlc(1)
+	live.addTime = time.Unix(299, 0)
+	live.resolveTime = time.Unix(300, 0)
+	require.NoError(t, db.RecordHtlcResolution(ctx, mockIdentity, live))
+
+	s := NewServer(
+		zap.NewNop().Sugar(),
+		[]*lndNode{newLndNode(mockIdentity, lnd, nil)}, db,
+	)
+
+	req := &circuitbreakerrpc.ImportForwardingHistoryRequest{}
+	resp, err := s.ImportForwardingHistory(ctx, req)
+	require.NoError(t, err)
+	require.EqualValues(t, importPageSize+8, resp.Imported)
+	require.EqualValues(t, 2, resp.Skipped)
+
+	// Importing again skips everything.
+	resp, err = s.ImportForwardingHistory(ctx, req)
+	require.NoError(t, err)
+	require.Zero(t, resp.Imported)
+	require.EqualValues(t, importPageSize+10, resp.Skipped)
+
+	htlcs, err := db.ListForwardingHistory(
+		ctx, mockIdentity, &ForwardingHistoryQuery{
+			Start: time.Unix(0, 0),
+			End:   time.Unix(1000, 0),
+			Limit: 1,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, htlcs, 1)
+
+	imported := htlcs[0]
+	require.Equal(t, sourceLnd, imported.source)
+	require.True(t, imported.settled)
+	require.Equal(t, time.Unix(100, 0), imported.resolveTime)
+	require.Equal(t, testChannels[2].peer, imported.incomingPeer)
+	require.Equal(t, testChannels[4].peer, imported.outgoingPeer)
+
+	_, ok := imported.holdTime()
+	require.False(t, ok)
+
+	// Imported forwards are included in the rollups.
+	stats, err := db.ListForwardingStats(
+		ctx, mockIdentity, &ForwardingStatsQuery{
+			Granularity: granularityDay,
+			Start:       time.Unix(0, 0),
+			End:         time.Unix(1000, 0),
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	require.EqualValues(t, importPageSize+8, stats[1].SettledCount)
+	require.EqualValues(t, 10*(importPageSize+8), stats[1].Fees)
+	require.Zero(t, stats[1].HoldTime)
+
+	// Prune the complete forwarding history, including the live forward.
+	// The rollups are kept for longer.
+	require.NoError(t, db.prune(ctx, time.Second, time.Unix(1000, 0)))
+
+	htlcs, err = db.ListForwardingHistory(
+		ctx, mockIdentity, &ForwardingHistoryQuery{
+			Start: time.Unix(0, 0),
+			End:   time.Unix(1000, 0),
+		},
+	)
+	require.NoError(t, err)
+	require.Empty(t, htlcs)
+
+	// Importing after pruning still skips everything, because the rollups
+	// already include the pruned forwards.
+	resp, err = s.ImportForwardingHistory(ctx, req)
+	require.NoError(t, err)
+	require.Zero(t, resp.Imported)
+	require.EqualValues(t, importPageSize+10, resp.Skipped)
+
+	statsAfterPrune, err := db.ListForwardingStats(
+		ctx, mockIdentity, &ForwardingStatsQuery{
+			Granularity: granularityDay,
+			Start:       time.Unix(0, 0),
+			End:         time.Unix(1000, 0),
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, stats, statsAfterPrune)
+}
+
+// forwardingHistoryRecorder is a lightning client that records the forwarding
+// history requests that it receives.
+type forwardingHistoryRecorder struct {
+	lnrpc.LightningClient
+
+	requests []*lnrpc.ForwardingHistoryRequest
+}
+
+func (f *forwardingHistoryRecorder) ForwardingHistory(_ context.Context,
+	req *lnrpc.ForwardingHistoryRequest, _ ...grpc.CallOption) (
+	*lnrpc.ForwardingHistoryResponse, error) {
+
+	f.requests = append(f.requests, req)
+
+	return &lnrpc.ForwardingHistoryResponse{}, nil
+}
+
+func TestForwardingHistoryRequest(t *testing.T) {
+	recorder := &forwardingHistoryRecorder{}
+	client := &lndclientGrpc{main: recorder}
+
+	// An open start, as parsed from an import request without a start
+	// time, asks lnd for its whole log.
+	start, end, err := parseTimeRange(0, int64(200*time.Second))
+	require.NoError(t, err)
+
+	_, _, err = client.forwardingHistory(start, end, 10, importPageSize)
+	require.NoError(t, err)
+
+	_, _, err = client.forwardingHistory(
+		time.Unix(100, 0), time.Unix(200, 0), 0, importPageSize,
+	)
+	require.NoError(t, err)
+
+	require.Len(t, recorder.requests, 2)
+
+	req := recorder.requests[0]
+	require.Zero(t, req.StartTime)
+	require.EqualValues(t, 200, req.EndTime)
+	require.EqualValues(t, 10, req.IndexOffset)
+	require.EqualValues(t, importPageSize, req.NumMaxEvents)
+
+	req = recorder.requests[1]
+	require.EqualValues(t, 100, req.StartTime)
+	require.EqualValues(t, 200, req.EndTime)
+}
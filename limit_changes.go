@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/lightningequipment/circuitbreaker/circuitbreakerrpc"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// gatewayClientKey is the metadata key under which the rest gateway passes on
+// the address of the http client. Clients can't set it themselves, see
+// gatewayHeaderMatcher.
+const gatewayClientKey = "circuitbreaker-gateway-client"
+
+// gatewayAddr is the remote address of the connections of the rest gateway to
+// the grpc server.
+type gatewayAddr struct{}
+
+func (gatewayAddr) Network() string { return "gateway" }
+
+func (gatewayAddr) String() string { return "gateway" }
+
+// gatewayConn is a connection of the rest gateway to the grpc server.
+type gatewayConn struct {
+	net.Conn
+}
+
+func (gatewayConn) RemoteAddr() net.Addr {
+	return gatewayAddr{}
+}
+
+// gatewayListener is the in-process listener that the rest gateway connects
+// to. Its connections are marked, so that the grpc server can tell calls
+// through the gateway apart from direct calls.
+type gatewayListener struct {
+	*bufconn.Listener
+}
+
+func (l gatewayListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	return gatewayConn{conn}, nil
+}
+
+// gatewayClientMetadata passes the address of the http client on to the grpc
+// server.
+func gatewayClientMetadata(_ context.Context, r *http.Request) metadata.MD {
+	return metadata.Pairs(gatewayClientKey, r.RemoteAddr)
+}
+
+// gatewayHeaderMatcher passes the same http headers on to the grpc server as
+// the default matcher of the gateway, except for headers that would pose as
+// the client address.
+func gatewayHeaderMatcher(key string) (string, bool) {
+	key, ok := runtime.DefaultHeaderMatcher(key)
+	if !ok || strings.EqualFold(key, gatewayClientKey) {
+		return "", false
+	}
+
+	return key, true
+}
+
+// callerIdentity describes the api caller for the limit change and queue action
+// audit logs. Calls through the rest gateway are identified by the address of
+// the http client, direct grpc calls by the address of the grpc client. The
+// client address that the gateway passes on is only trusted on connections of
+// the gateway. If the call was authenticated, the label of the token is
+// included.
+func callerIdentity(ctx context.Context) string {
+	address := callerAddress(ctx)
+
+	if label, ok := tokenLabelFromContext(ctx); ok {
+		return fmt.Sprintf("%v (%v)", label, address)
+	}
+
+	return address
+}
+
+// callerAddress describes the address that an api call originates from.
+func callerAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "unknown"
+	}
+
+	if _, ok := p.Addr.(gatewayAddr); !ok {
+		return "grpc " + p.Addr.String()
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if client := md.Get(gatewayClientKey); len(client) > 0 {
+		return "rest " + client[0]
+	}
+
+	return "rest unknown"
+}
+
+func (s *server) ListLimitChanges(ctx context.Context,
+	req *circuitbreakerrpc.ListLimitChangesRequest) (
+	*circuitbreakerrpc.ListLimitChangesResponse, error) {
+
+	lndNode, err := s.getNode(req.NodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &LimitChangesQuery{
+		Limit: int(req.Limit),
+	}
+
+	switch {
+	case req.DefaultLimit && req.Node != "":
+		return nil, fmt.Errorf("cannot filter on both node and default " +
+			"limit")
+
+	case req.DefaultLimit:
+		query.Peer = &defaultNodeKey
+
+	default:
+		query.Peer, err = parseOptionalPeer(req.Node)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	changes, err := s.db.ListLimitChanges(ctx, lndNode.key, query)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcChanges := make([]*circuitbreakerrpc.LimitChange, 0, len(changes))
+	for _, change := range changes {
+		rpcChange := &circuitbreakerrpc.LimitChange{
+			Id:               change.ID,
+			TimestampNs:      change.Time.UnixNano(),
+			Caller:           change.Caller,
+			RevertedChangeId: change.RevertedChange,
+		}
+
+		if change.Peer != defaultNodeKey {
+			rpcChange.Node = change.Peer.String()
+		}
+
+		if change.Old != nil {
+			rpcChange.OldLimit, err = marshalLimit(*change.Old)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if change.New != nil {
+			rpcChange.NewLimit, err = marshalLimit(*change.New)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		rpcChanges = append(rpcChanges, rpcChange)
+	}
+
+	return &circuitbreakerrpc.ListLimitChangesResponse{
+		Changes: rpcChanges,
+	}, nil
+}
+
+func (s *server) RevertLimit(ctx context.Context,
+	req *circuitbreakerrpc.RevertLimitRequest) (
+	*circuitbreakerrpc.RevertLimitResponse, error) {
+
+	lndNode, err := s.getNode(req.NodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	node := defaultNodeKey
+	if req.Node != "" {
+		node, err = route.NewVertexFromStr(req.Node)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	update, err := s.db.RevertLimit(
+		ctx, lndNode.key, node, callerIdentity(ctx),
+		func(limit *Limit) error {
+			if limit == nil {
+				return nil
+			}
+
+			return lndNode.process.checkLimit(*limit)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infow("Reverted limit", "lndNode", lndNode.key, "node", node,
+		"changeId", update.RevertedChange, "limit", update.Limit)
+
+	err = s.handOffLimits(lndNode, []LimitUpdate{*update})
+	if err != nil {
+		return nil, err
+	}
+
+	return &circuitbreakerrpc.RevertLimitResponse{
+		RevertedChangeId: update.RevertedChange,
+	}, nil
+}
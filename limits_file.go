@@ -0,0 +1,424 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v3"
+)
+
+// limitsFileCaller identifies changes made by the limits file in the limit
+// change audit log.
+const limitsFileCaller = "limits file"
+
+var limitsFileFlag = cli.StringFlag{
+	Name: "limits.file",
+	Usage: "yaml or toml file with the default and per-peer limits. " +
+		"The file is applied on startup and on SIGHUP, and replaces " +
+		"limits that are set through the api",
+}
+
+// limitsFileLimit is a limit as specified in the limits file.
+type limitsFileLimit struct {
+	MaxHourlyRate int64  `yaml:"max_hourly_rate" toml:"max_hourly_rate"`
+	MaxPending    int64  `yaml:"max_pending" toml:"max_pending"`
+	Mode          string `yaml:"mode" toml:"mode"`
+}
+
+func (l *limitsFileLimit) limit() (Limit, error) {
+	limit := Limit{
+		MaxHourlyRate: l.MaxHourlyRate,
+		MaxPending:    l.MaxPending,
+	}
+
+	if l.MaxHourlyRate < 0 || l.MaxPending < 0 {
+		return Limit{}, errors.New("limits cannot be negative")
+	}
+
+	if l.Mode != "" {
+		mode, err := parseMode(strings.ToUpper(l.Mode))
+		if err != nil {
+			return Limit{}, err
+		}
+		limit.Mode = mode
+	}
+
+	return limit, nil
+}
+
+// limitsFile is the declarative limit configuration. If the default limit is
+// not set, the current default limit is kept. Peers are identified by pubkey
+// or alias. Peers that are not listed have no limit of their own.
+type limitsFile struct {
+	Default *limitsFileLimit           `yaml:"default" toml:"default"`
+	Peers   map[string]limitsFileLimit `yaml:"peers" toml:"peers"`
+}
+
+// loadLimitsFile reads a limits file. The format is derived from the file
+// extension.
+func loadLimitsFile(path string) (*limitsFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file limitsFile
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+
+		// An empty file decodes to io.EOF, which is a valid, empty
+		// configuration.
+		if err := decoder.Decode(&file); err != nil && len(data) > 0 {
+			return nil, fmt.Errorf("invalid limits file %v: %w", path,
+				err)
+		}
+
+	case ".toml":
+		meta, err := toml.Decode(string(data), &file)
+		if err != nil {
+			return nil, fmt.Errorf("invalid limits file %v: %w", path,
+				err)
+		}
+
+		if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+			return nil, fmt.Errorf("invalid limits file %v: unknown "+
+				"keys %v", path, undecoded)
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown limits file format %v, use "+
+			".yaml, .yml or .toml", ext)
+	}
+
+	return &file, nil
+}
+
+// resolve converts the file into the limits to apply. The aliases function
+// provides the aliases of the known peers and is only called if the file
+// refers to peers by alias.
+func (f *limitsFile) resolve(
+	aliases func() (map[route.Vertex]string, error)) (*Limit,
+	map[route.Vertex]Limit, error) {
+
+	var defaultLimit *Limit
+	if f.Default != nil {
+		limit, err := f.Default.limit()
+		if err != nil {
+			return nil, nil, fmt.Errorf("default limit: %w", err)
+		}
+		defaultLimit = &limit
+	}
+
+	var peersByAlias map[string][]route.Vertex
+
+	perPeer := make(map[route.Vertex]Limit, len(f.Peers))
+	for name, fileLimit := range f.Peers {
+		limit, err := fileLimit.limit()
+		if err != nil {
+			return nil, nil, fmt.Errorf("limit for %v: %w", name, err)
+		}
+
+		peer, err := route.NewVertexFromStr(name)
+		if err != nil {
+			if peersByAlias == nil {
+				known, err := aliases()
+				if err != nil {
+					return nil, nil, err
+				}
+
+				peersByAlias = make(map[string][]route.Vertex)
+				for peer, alias := range known {
+					peersByAlias[alias] = append(
+						peersByAlias[alias], peer,
+					)
+				}
+			}
+
+			peers := peersByAlias[name]
+			switch len(peers) {
+			case 0:
+				return nil, nil, fmt.Errorf("no peer with alias %v",
+					name)
+
+			case 1:
+				peer = peers[0]
+
+			default:
+				return nil, nil, fmt.Errorf("alias %v is used by "+
+					"multiple peers, use the pubkey instead", name)
+			}
+		}
+
+		if peer == defaultNodeKey {
+			return nil, nil, errors.New("set the default limit " +
+				"through default")
+		}
+
+		if _, ok := perPeer[peer]; ok {
+			return nil, nil, fmt.Errorf("multiple limits for %v", peer)
+		}
+
+		perPeer[peer] = limit
+	}
+
+	return defaultLimit, perPeer, nil
+}
+
+// diffLimits returns the updates that turn the current limits into the desired
+// limits, ordered by peer. A nil default limit leaves the current default
+// limit unchanged.
+func diffLimits(current *Limits, defaultLimit *Limit,
+	perPeer map[route.Vertex]Limit) []LimitUpdate {
+
+	var updates []LimitUpdate
+	if defaultLimit != nil && *defaultLimit != current.Default {
+		updates = append(updates, LimitUpdate{
+			Peer:  defaultNodeKey,
+			Limit: defaultLimit,
+		})
+	}
+
+	for peer, limit := range perPeer {
+		limit := limit
+
+		currentLimit, ok := current.PerPeer[peer]
+		if ok && currentLimit == limit {
+			continue
+		}
+
+		updates = append(updates, LimitUpdate{Peer: peer, Limit: &limit})
+	}
+
+	for peer := range current.PerPeer {
+		if _, ok := perPeer[peer]; !ok {
+			updates = append(updates, LimitUpdate{Peer: peer})
+		}
+	}
+
+	sort.Slice(updates, func(i, j int) bool {
+		return bytes.Compare(updates[i].Peer[:], updates[j].Peer[:]) < 0
+	})
+
+	return updates
+}
+
+// peerAliases returns the aliases of the peers of all open and closed channels
+// of a node.
+func peerAliases(lnd lndclient,
+	alias func(route.Vertex) (string, error)) (map[route.Vertex]string,
+	error) {
+
+	channels, err := lnd.listChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	closedChannels, err := lnd.listClosedChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := make(map[route.Vertex]string)
+	for _, chans := range []map[uint64]*channel{channels, closedChannels} {
+		for _, ch := range chans {
+			if _, ok := aliases[ch.peer]; ok {
+				continue
+			}
+
+			peerAlias, err := alias(ch.peer)
+			switch {
+			case err == ErrNodeNotFound:
+
+			case err != nil:
+				return nil, err
+			}
+
+			aliases[ch.peer] = peerAlias
+		}
+	}
+
+	return aliases, nil
+}
+
+// limitsFileUpdates returns the current limits of a node and the updates that
+// are required to apply the limits file to it.
+func limitsFileUpdates(ctx context.Context, file *limitsFile, db Store,
+	node route.Vertex, lnd lndclient,
+	alias func(route.Vertex) (string, error)) (*Limits, []LimitUpdate,
+	error) {
+
+	defaultLimit, perPeer, err := file.resolve(
+		func() (map[route.Vertex]string, error) {
+			return peerAliases(lnd, alias)
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	current, err := db.GetLimits(ctx, node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return current, diffLimits(current, defaultLimit, perPeer), nil
+}
+
+// reloadLimitsFile applies the limits file to all nodes. The updates of all
+// nodes are validated before any node is updated.
+func (s *server) reloadLimitsFile(ctx context.Context, path string) error {
+	file, err := loadLimitsFile(path)
+	if err != nil {
+		return err
+	}
+
+	nodeUpdates := make([][]LimitUpdate, len(s.nodes))
+	for i, node := range s.nodes {
+		node := node
+
+		_, updates, err := limitsFileUpdates(
+			ctx, file, s.db, node.key, node.lnd,
+			func(key route.Vertex) (string, error) {
+				return s.getAlias(node, key)
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("node %v: %w", node.key, err)
+		}
+
+		for _, update := range updates {
+			if update.Limit == nil {
+				continue
+			}
+
+			err := node.process.checkLimit(*update.Limit)
+			if err != nil {
+				return fmt.Errorf("node %v: %w", node.key, err)
+			}
+		}
+
+		nodeUpdates[i] = updates
+	}
+
+	for i, node := range s.nodes {
+		updates := nodeUpdates[i]
+		if len(updates) == 0 {
+			continue
+		}
+
+		err := s.updateLimits(ctx, node, updates, limitsFileCaller)
+		if err != nil {
+			return fmt.Errorf("node %v: %w", node.key, err)
+		}
+	}
+
+	return nil
+}
+
+// formatLimit formats an optional limit for the diff command.
+func formatLimit(limit *Limit) string {
+	if limit == nil {
+		return "none"
+	}
+
+	return fmt.Sprintf("max_hourly_rate=%v max_pending=%v mode=%v",
+		limit.MaxHourlyRate, limit.MaxPending, limit.Mode)
+}
+
+var diffLimitsCommand = cli.Command{
+	Name:      "difflimits",
+	Usage:     "show the changes that applying a limits file would make",
+	ArgsUsage: "[limits file]",
+	Description: "Compares the limits file with the limits in the " +
+		"database of every configured lnd node without changing " +
+		"anything. The file defaults to the value of --limits.file.",
+	Action: diffLimitsFile,
+}
+
+func diffLimitsFile(c *cli.Context) error {
+	ctx := context.Background()
+
+	path := c.Args().First()
+	if path == "" {
+		path = c.GlobalString(limitsFileFlag.Name)
+	}
+	if path == "" {
+		return errors.New("no limits file specified")
+	}
+
+	file, err := loadLimitsFile(path)
+	if err != nil {
+		return err
+	}
+
+	db, err := openDb(ctx, c, c.GlobalString("configdir"))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	lndCfgs, err := lndConfigsFromCli(c)
+	if err != nil {
+		return err
+	}
+
+	for _, lndCfg := range lndCfgs {
+		lndClient, err := NewLndClient(lndCfg)
+		if err != nil {
+			return err
+		}
+		defer lndClient.Close()
+
+		info, err := lndClient.getInfo()
+		if err != nil {
+			return err
+		}
+
+		current, updates, err := limitsFileUpdates(
+			ctx, file, db, info.nodeKey, lndClient,
+			lndClient.getNodeAlias,
+		)
+		if err != nil {
+			return fmt.Errorf("node %v: %w", info.nodeKey, err)
+		}
+
+		if len(updates) == 0 {
+			fmt.Printf("Node %v (%v): no changes\n", info.nodeKey,
+				info.alias)
+
+			continue
+		}
+
+		fmt.Printf("Node %v (%v):\n", info.nodeKey, info.alias)
+		for _, update := range updates {
+			if update.Peer == defaultNodeKey {
+				fmt.Printf("  default: %v -> %v\n",
+					formatLimit(&current.Default),
+					formatLimit(update.Limit))
+
+				continue
+			}
+
+			var currentLimit *Limit
+			if limit, ok := current.PerPeer[update.Peer]; ok {
+				currentLimit = &limit
+			}
+
+			fmt.Printf("  %v: %v -> %v\n", update.Peer,
+				formatLimit(currentLimit), formatLimit(update.Limit))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func writeLimitsFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
+
+func TestLoadLimitsFile(t *testing.T) {
+	yamlPath := writeLimitsFile(t, "limits.yaml", `
+default:
+  max_hourly_rate: 3600
+  max_pending: 5
+peers:
+  alias-030000:
+    max_pending: 10
+    mode: queue
+`)
+
+	tomlPath := writeLimitsFile(t, "limits.toml", `
+[default]
+max_hourly_rate = 3600
+max_pending = 5
+
+[peers.alias-030000]
+max_pending = 10
+mode = "queue"
+`)
+
+	expected := &limitsFile{
+		Default: &limitsFileLimit{MaxHourlyRate: 3600, MaxPending: 5},
+		Peers: map[string]limitsFileLimit{
+			"alias-030000": {MaxPending: 10, Mode: "queue"},
+		},
+	}
+
+	for _, path := range []string{yamlPath, tomlPath} {
+		file, err := loadLimitsFile(path)
+		require.NoError(t, err)
+		require.Equal(t, expected, file)
+	}
+
+	// Typos are not silently ignored.
+	_, err := loadLimitsFile(writeLimitsFile(t, "limits.yaml", `
+default:
+  max_pendin: 5
+`))
+	require.Error(t, err)
+
+	_, err = loadLimitsFile(writeLimitsFile(t, "limits.toml", `
+[default]
+max_pendin = 5
+`))
+	require.Error(t, err)
+
+	_, err = loadLimitsFile(writeLimitsFile(t, "limits.json", `{}`))
+	require.Error(t, err)
+}
+
+func TestReloadLimitsFile(t *testing.T) {
+	defer Timeout()()
+
+	ctx := context.Background()
+
+	db, cleanup := setupTestDb(t, defaultFwdHistoryLimit)
+	defer cleanup()
+
+	require.NoError(t, db.InitNode(ctx, mockIdentity, false))
+
+	peer2, peer3, peer4 := route.Vertex{2}, route.Vertex{3}, route.Vertex{4}
+
+	// A limit set through the api that isn't in the file.
+	apiLimit := Limit{MaxPending: 1, Mode: ModeBlock}
+	require.NoError(t, db.UpdateLimit(ctx, mockIdentity, peer4, apiLimit, "api"))
+
+	log := zaptest.NewLogger(t).Sugar()
+	client := newLndclientMock(testChannels, nil)
+	p := NewProcess(client, log, &Limits{}, db)
+
+	s := NewServer(log, []*lndNode{newLndNode(mockIdentity, client, p)}, db)
+
+	events := make(chan updateLimitEvent, 10)
+	go func() {
+		for event := range p.updateLimitChan {
+			events <- event
+		}
+	}()
+
+	// Peers can be referenced by pubkey and by alias.
+	path := writeLimitsFile(t, "limits.yaml", `
+default:
+  max_hourly_rate: 60
+  max_pending: 2
+peers:
+  `+peer2.String()+`:
+    max_pending: 10
+  alias-030000:
+    max_hourly_rate: 120
+    mode: block
+`)
+
+	require.NoError(t, s.reloadLimitsFile(ctx, path))
+
+	defaultLimit := Limit{MaxHourlyRate: 60, MaxPending: 2}
+	limit2 := Limit{MaxPending: 10}
+	limit3 := Limit{MaxHourlyRate: 120, Mode: ModeBlock}
+
+	limits, err := db.GetLimits(ctx, mockIdentity)
+	require.NoError(t, err)
+	require.Equal(t, defaultLimit, limits.Default)
+	require.Equal(t, map[route.Vertex]Limit{
+		peer2: limit2,
+		peer3: limit3,
+	}, limits.PerPeer)
+
+	// The process receives all changes as a single batch.
+	event := <-events
+	require.Equal(t, []LimitUpdate{
+		{Peer: defaultNodeKey, Limit: &defaultLimit},
+		{Peer: peer2, Limit: &limit2},
+		{Peer: peer3, Limit: &limit3},
+		{Peer: peer4},
+	}, event.updates)
+
+	changes, err := db.ListLimitChanges(
+		ctx, mockIdentity, &LimitChangesQuery{Limit: 1},
+	)
+	require.NoError(t, err)
+	require.Equal(t, limitsFileCaller, changes[0].Caller)
+
+	// Reloading an unchanged file doesn't change anything.
+	require.NoError(t, s.reloadLimitsFile(ctx, path))
+
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected batch: %v", event)
+
+	default:
+	}
+
+	// An unknown alias is rejected without changing any limits.
+	path = writeLimitsFile(t, "limits.yaml", `
+peers:
+  unknown:
+    max_pending: 1
+`)
+	require.ErrorContains(t, s.reloadLimitsFile(ctx, path), "unknown")
+
+	limits, err = db.GetLimits(ctx, mockIdentity)
+	require.NoError(t, err)
+	require.Len(t, limits.PerPeer, 2)
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
@@ -17,7 +18,6 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
-	"gopkg.in/macaroon.v2"
 )
 
 var (
@@ -34,6 +34,10 @@ type lndclientGrpc struct {
 	conn *grpc.ClientConn
 	log  *zap.SugaredLogger
 
+	// macaroon is the serialized macaroon that the client authenticates
+	// with.
+	macaroon []byte
+
 	main   lnrpc.LightningClient
 	router routerrpc.RouterClient
 }
@@ -108,6 +112,10 @@ type interceptedEvent struct {
 	circuitKey   circuitKey
 	incomingMsat lnwire.MilliSatoshi
 	outgoingMsat lnwire.MilliSatoshi
+
+	// outgoingChannel is the channel that the htlc is requested to be
+	// forwarded over.
+	outgoingChannel uint64
 }
 
 func (h *lndHtlcInterceptorClient) recv() (*interceptedEvent, error) {
@@ -121,8 +129,9 @@ func (h *lndHtlcInterceptorClient) recv() (*interceptedEvent, error) {
 			channel: event.IncomingCircuitKey.ChanId,
 			htlc:    event.IncomingCircuitKey.HtlcId,
 		},
-		incomingMsat: lnwire.MilliSatoshi(event.IncomingAmountMsat),
-		outgoingMsat: lnwire.MilliSatoshi(event.OutgoingAmountMsat),
+		incomingMsat:    lnwire.MilliSatoshi(event.IncomingAmountMsat),
+		outgoingMsat:    lnwire.MilliSatoshi(event.OutgoingAmountMsat),
+		outgoingChannel: event.OutgoingRequestedChanId,
 	}, nil
 }
 
@@ -149,15 +158,54 @@ func (h *lndHtlcInterceptorClient) send(resp *interceptResponse) error {
 
 type LndConfig struct {
 	TlsCertPath, MacPath, RpcServer string
-	Log                             *zap.SugaredLogger
+
+	// TlsCert is the PEM encoded TLS certificate of lnd. If set, it takes
+	// precedence over TlsCertPath. If neither is set, the system roots are
+	// used to verify lnd's certificate.
+	TlsCert []byte
+
+	// Macaroon is the serialized macaroon to authenticate with. If set, it
+	// takes precedence over MacPath.
+	Macaroon []byte
+
+	Log *zap.SugaredLogger
 }
 
-func NewLndClient(cfg *LndConfig) (*lndclientGrpc, error) {
-	// Load the specified TLS certificate and build transport credentials
-	// with it.
-	creds, err := credentials.NewClientTLSFromFile(cfg.TlsCertPath, "")
-	if err != nil {
-		return nil, err
+func (cfg *LndConfig) transportCredentials() (
+	credentials.TransportCredentials, error) {
+
+	switch {
+	case cfg.TlsCert != nil:
+		pool, err := certPoolFromPEM(cfg.TlsCert)
+		if err != nil {
+			return nil, err
+		}
+
+		return credentials.NewClientTLSFromCert(pool, ""), nil
+
+	case cfg.TlsCertPath != "":
+		// Load the specified TLS certificate and build transport
+		// credentials with it.
+		certPEM, err := os.ReadFile(cfg.TlsCertPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read tls cert: %w", err)
+		}
+
+		pool, err := certPoolFromPEM(certPEM)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", cfg.TlsCertPath, err)
+		}
+
+		return credentials.NewClientTLSFromCert(pool, ""), nil
+
+	default:
+		return credentials.NewClientTLSFromCert(nil, ""), nil
+	}
+}
+
+func (cfg *LndConfig) macaroon() ([]byte, error) {
+	if cfg.Macaroon != nil {
+		return cfg.Macaroon, nil
 	}
 
 	// Load the specified macaroon file.
@@ -167,9 +215,23 @@ func NewLndClient(cfg *LndConfig) (*lndclientGrpc, error) {
 			"the network setting!): %v", err)
 	}
 
-	mac := &macaroon.Macaroon{}
-	if err = mac.UnmarshalBinary(macBytes); err != nil {
-		return nil, fmt.Errorf("unable to decode macaroon: %v", err)
+	return macBytes, nil
+}
+
+func NewLndClient(cfg *LndConfig) (*lndclientGrpc, error) {
+	creds, err := cfg.transportCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	macBytes, err := cfg.macaroon()
+	if err != nil {
+		return nil, err
+	}
+
+	mac, err := validateMacaroon(macBytes)
+	if err != nil {
+		return nil, err
 	}
 
 	// Now we append the macaroon credentials to the dial options.
@@ -191,17 +253,75 @@ func NewLndClient(cfg *LndConfig) (*lndclientGrpc, error) {
 	}
 
 	return &lndclientGrpc{
-		log:    cfg.Log,
-		conn:   conn,
-		main:   lnrpc.NewLightningClient(conn),
-		router: routerrpc.NewRouterClient(conn),
+		log:      cfg.Log,
+		conn:     conn,
+		macaroon: macBytes,
+		main:     lnrpc.NewLightningClient(conn),
+		router:   routerrpc.NewRouterClient(conn),
 	}, nil
 }
 
+// bakeMacaroon bakes a new macaroon that grants the permissions provided and
+// returns it in serialized form.
+func (l *lndclientGrpc) bakeMacaroon(perms []*lnrpc.MacaroonPermission) (
+	[]byte, error) {
+
+	ctx, cancel := context.WithTimeout(ctxb, rpcTimeout)
+	defer cancel()
+
+	resp, err := l.main.BakeMacaroon(ctx, &lnrpc.BakeMacaroonRequest{
+		Permissions: perms,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hex.DecodeString(resp.Macaroon)
+}
+
+// checkMacaroonPermissions verifies that the macaroon that the client uses
+// grants all of the permissions provided. Every permission is checked
+// individually so that the missing ones can be reported precisely.
+func (l *lndclientGrpc) checkMacaroonPermissions(
+	perms []*lnrpc.MacaroonPermission) error {
+
+	var missing []*lnrpc.MacaroonPermission
+	for _, perm := range perms {
+		ctx, cancel := context.WithTimeout(ctxb, rpcTimeout)
+		_, err := l.main.CheckMacaroonPermissions(
+			ctx, &lnrpc.CheckMacPermRequest{
+				Macaroon:    l.macaroon,
+				Permissions: []*lnrpc.MacaroonPermission{perm},
+			},
+		)
+		cancel()
+
+		switch {
+		// Lnd reports an invalid argument if the permission isn't granted.
+		case status.Code(err) == codes.InvalidArgument:
+			missing = append(missing, perm)
+
+		case err != nil:
+			return err
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %v", errMissingPermissions,
+			formatPermissions(missing))
+	}
+
+	return nil
+}
+
 type info struct {
 	nodeKey route.Vertex
 	alias   string
 	version string
+
+	// requireInterceptor indicates whether lnd holds htlcs while no
+	// interceptor is connected.
+	requireInterceptor bool
 }
 
 func (l *lndclientGrpc) getInfo() (*info, error) {
@@ -222,12 +342,17 @@ func (l *lndclientGrpc) getInfo() (*info, error) {
 		nodeKey: nodeKey,
 		alias:   infoResp.Alias,
 		version: infoResp.Version,
+
+		requireInterceptor: infoResp.RequireHtlcInterceptor,
 	}, nil
 }
 
 type channel struct {
 	peer      route.Vertex
 	initiator bool
+
+	// active indicates that the channel is open and the peer is online.
+	active bool
 }
 
 func (l *lndclientGrpc) listChannels() (map[uint64]*channel, error) {
@@ -249,6 +374,7 @@ func (l *lndclientGrpc) listChannels() (map[uint64]*channel, error) {
 		chans[rpcChan.ChanId] = &channel{
 			peer:      peer,
 			initiator: rpcChan.Initiator,
+			active:    rpcChan.Active,
 		}
 	}
 
@@ -298,6 +424,65 @@ func (l *lndclientGrpc) listClosedChannels() (map[uint64]*channel, error) {
 	return chans, nil
 }
 
+// forwardingEvent is a settled forward from lnd's forwarding log.
+type forwardingEvent struct {
+	timestamp       time.Time
+	incomingChannel uint64
+	outgoingChannel uint64
+	incomingMsat    lnwire.MilliSatoshi
+	outgoingMsat    lnwire.MilliSatoshi
+}
+
+// unixSeconds converts a time to the unsigned unix timestamp that lnd expects.
+// Times before the epoch, such as the zero time for an open start, are clamped
+// to zero rather than wrapping around to a timestamp far in the future.
+func unixSeconds(t time.Time) uint64 {
+	if t.Unix() < 0 {
+		return 0
+	}
+
+	return uint64(t.Unix())
+}
+
+// forwardingHistory returns at most maxEvents forwards from lnd's forwarding
+// log that happened between start and end, skipping the first offset events.
+// The offset to pass in for the next page is returned as well.
+func (l *lndclientGrpc) forwardingHistory(start, end time.Time, offset,
+	maxEvents uint32) ([]*forwardingEvent, uint32, error) {
+
+	ctx, cancel := context.WithTimeout(ctxb, rpcTimeout)
+	defer cancel()
+
+	resp, err := l.main.ForwardingHistory(ctx, &lnrpc.ForwardingHistoryRequest{
+		StartTime:    unixSeconds(start),
+		EndTime:      unixSeconds(end),
+		IndexOffset:  offset,
+		NumMaxEvents: maxEvents,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	events := make([]*forwardingEvent, 0, len(resp.ForwardingEvents))
+	for _, rpcEvent := range resp.ForwardingEvents {
+		// Older versions of lnd only report the timestamp in seconds.
+		timestamp := time.Unix(0, int64(rpcEvent.TimestampNs))
+		if rpcEvent.TimestampNs == 0 {
+			timestamp = time.Unix(int64(rpcEvent.Timestamp), 0)
+		}
+
+		events = append(events, &forwardingEvent{
+			timestamp:       timestamp,
+			incomingChannel: rpcEvent.ChanIdIn,
+			outgoingChannel: rpcEvent.ChanIdOut,
+			incomingMsat:    lnwire.MilliSatoshi(rpcEvent.AmtInMsat),
+			outgoingMsat:    lnwire.MilliSatoshi(rpcEvent.AmtOutMsat),
+		})
+	}
+
+	return events, resp.LastOffsetIndex, nil
+}
+
 func (l *lndclientGrpc) subscribeHtlcEvents(ctx context.Context) (
 	htlcEventsClient, error) {
 
@@ -391,8 +576,11 @@ func (l *lndclientGrpc) getPendingIncomingHtlcs(ctx context.Context, peer *route
 
 			// Note: we cannot easily recover added timestamp or incoming
 			// and outgoing amounts on resume, so we leave these values as
-			// zero to indicate that they are unknown due to restart.
-			htlcs[key] = &inFlightHtlc{}
+			// zero to indicate that they are unknown due to restart. Htlcs
+			// without a forwarding channel haven't been forwarded yet.
+			htlcs[key] = &inFlightHtlc{
+				held: htlc.ForwardingChannel == 0,
+			}
 		}
 	}
 
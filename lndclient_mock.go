@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"time"
 
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"github.com/lightningnetwork/lnd/routing/route"
@@ -29,6 +30,15 @@ type lndclientMock struct {
 
 	channels       map[uint64]*channel
 	closedChannels map[uint64]*channel
+
+	// pendingHtlcs are reported as pending incoming htlcs in addition to the
+	// empty sets for all channel peers.
+	pendingHtlcs map[route.Vertex]map[circuitKey]*inFlightHtlc
+
+	requireInterceptor bool
+
+	// forwardingEvents is the forwarding log of the mock.
+	forwardingEvents []*forwardingEvent
 }
 
 func newLndclientMock(channels, closedChannels map[uint64]*channel) *lndclientMock {
@@ -44,7 +54,8 @@ func newLndclientMock(channels, closedChannels map[uint64]*channel) *lndclientMo
 
 func (l *lndclientMock) getInfo() (*info, error) {
 	return &info{
-		nodeKey: mockIdentity,
+		nodeKey:            mockIdentity,
+		requireInterceptor: l.requireInterceptor,
 	}, nil
 }
 
@@ -79,6 +90,30 @@ func (l *lndclientMock) getNodeAlias(key route.Vertex) (string, error) {
 	return "alias-" + key.String()[:6], nil
 }
 
+func (l *lndclientMock) forwardingHistory(start, end time.Time, offset,
+	maxEvents uint32) ([]*forwardingEvent, uint32, error) {
+
+	var events []*forwardingEvent
+	for _, event := range l.forwardingEvents {
+		if event.timestamp.Before(start) || !event.timestamp.Before(end) {
+			continue
+		}
+
+		events = append(events, event)
+	}
+
+	if int(offset) >= len(events) {
+		return nil, offset, nil
+	}
+	events = events[offset:]
+
+	if len(events) > int(maxEvents) {
+		events = events[:maxEvents]
+	}
+
+	return events, offset + uint32(len(events)), nil
+}
+
 func (l *lndclientMock) getPendingIncomingHtlcs(ctx context.Context, peer *route.Vertex) (
 	map[route.Vertex]map[circuitKey]*inFlightHtlc, error) {
 
@@ -88,6 +123,12 @@ func (l *lndclientMock) getPendingIncomingHtlcs(ctx context.Context, peer *route
 		htlcs[ch.peer] = make(map[circuitKey]*inFlightHtlc)
 	}
 
+	for peer, peerHtlcs := range l.pendingHtlcs {
+		for key, htlc := range peerHtlcs {
+			htlcs[peer][key] = htlc
+		}
+	}
+
 	return htlcs, nil
 }
 
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"gopkg.in/macaroon.v2"
+)
+
+const lndConnectScheme = "lndconnect"
+
+// lndConnectParams contains the connection details that are encoded in an
+// lndconnect uri.
+type lndConnectParams struct {
+	rpcServer string
+
+	// tlsCert is the PEM encoded TLS certificate of lnd. It is nil if the uri
+	// doesn't contain a certificate, in which case the system roots are used
+	// to verify lnd's certificate.
+	tlsCert []byte
+
+	macaroon []byte
+}
+
+// parseLndConnectURI parses an lndconnect uri of the form
+// lndconnect://host:port?cert=<base64url DER>&macaroon=<base64url>.
+func parseLndConnectURI(uri string) (*lndConnectParams, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid lndconnect uri: %w", err)
+	}
+
+	if u.Scheme != lndConnectScheme {
+		return nil, fmt.Errorf("invalid lndconnect uri: unexpected "+
+			"scheme %v", u.Scheme)
+	}
+
+	if u.Host == "" {
+		return nil, errors.New("invalid lndconnect uri: no host")
+	}
+
+	rpcServer := u.Host
+	if u.Port() == "" {
+		rpcServer = net.JoinHostPort(u.Hostname(), defaultRPCPort)
+	}
+
+	query := u.Query()
+
+	params := &lndConnectParams{
+		rpcServer: rpcServer,
+	}
+
+	if certStr := query.Get("cert"); certStr != "" {
+		certDer, err := decodeBase64URL(certStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid lndconnect uri: cannot "+
+				"decode cert: %w", err)
+		}
+
+		params.tlsCert = pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: certDer,
+		})
+	}
+
+	macStr := query.Get("macaroon")
+	if macStr == "" {
+		return nil, errors.New("invalid lndconnect uri: no macaroon")
+	}
+
+	params.macaroon, err = decodeBase64URL(macStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid lndconnect uri: cannot decode "+
+			"macaroon: %w", err)
+	}
+
+	return params, nil
+}
+
+// decodeBase64URL decodes url safe base64 with or without padding.
+func decodeBase64URL(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+// decodeMacaroon decodes a hex or base64 encoded macaroon.
+func decodeMacaroon(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+
+	macBytes, err := hex.DecodeString(s)
+	if err == nil {
+		return macBytes, nil
+	}
+
+	macBytes, err = base64.StdEncoding.DecodeString(s)
+	if err == nil {
+		return macBytes, nil
+	}
+
+	macBytes, err = decodeBase64URL(s)
+	if err == nil {
+		return macBytes, nil
+	}
+
+	return nil, errors.New("macaroon is neither hex nor base64 encoded")
+}
+
+// validateMacaroon checks that the provided bytes contain a valid serialized
+// macaroon.
+func validateMacaroon(macBytes []byte) (*macaroon.Macaroon, error) {
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return nil, fmt.Errorf("unable to decode macaroon: %w", err)
+	}
+
+	return mac, nil
+}
+
+// certPoolFromPEM creates a cert pool containing the PEM encoded certificates
+// provided.
+func certPoolFromPEM(certPEM []byte) (*x509.CertPool, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, errors.New("unable to decode tls cert: no PEM data " +
+			"found")
+	}
+
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return nil, fmt.Errorf("unable to parse tls cert: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(certPEM) {
+		return nil, errors.New("unable to add tls cert to pool")
+	}
+
+	return pool, nil
+}
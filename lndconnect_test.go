@@ -0,0 +1,58 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLndConnectURI(t *testing.T) {
+	mac := []byte{1, 2, 3, 250}
+	cert := []byte{4, 5, 6}
+
+	macStr := base64.RawURLEncoding.EncodeToString(mac)
+	certStr := base64.RawURLEncoding.EncodeToString(cert)
+
+	params, err := parseLndConnectURI(
+		"lndconnect://lnd:10010?cert=" + certStr + "&macaroon=" + macStr,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "lnd:10010", params.rpcServer)
+	require.Equal(t, mac, params.macaroon)
+
+	block, _ := pem.Decode(params.tlsCert)
+	require.NotNil(t, block)
+	require.Equal(t, cert, block.Bytes)
+
+	// Without port and cert.
+	params, err = parseLndConnectURI("lndconnect://lnd?macaroon=" + macStr)
+	require.NoError(t, err)
+	require.Equal(t, "lnd:"+defaultRPCPort, params.rpcServer)
+	require.Nil(t, params.tlsCert)
+
+	_, err = parseLndConnectURI("https://lnd?macaroon=" + macStr)
+	require.Error(t, err)
+
+	_, err = parseLndConnectURI("lndconnect://lnd")
+	require.Error(t, err)
+}
+
+func TestDecodeMacaroon(t *testing.T) {
+	mac := []byte{1, 2, 3, 250}
+
+	for _, encoded := range []string{
+		hex.EncodeToString(mac),
+		base64.StdEncoding.EncodeToString(mac),
+		base64.RawURLEncoding.EncodeToString(mac),
+	} {
+		decoded, err := decodeMacaroon(encoded)
+		require.NoError(t, err)
+		require.Equal(t, mac, decoded)
+	}
+
+	_, err := decodeMacaroon("not a macaroon!")
+	require.Error(t, err)
+}
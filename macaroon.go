@@ -0,0 +1,114 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+const defaultBakedMacaroonFilename = "circuitbreaker.macaroon"
+
+var errMissingPermissions = errors.New("macaroon is missing permissions")
+
+// requiredPermissions lists the lnd permissions that circuitbreaker needs to
+// operate.
+var requiredPermissions = []*lnrpc.MacaroonPermission{
+	// Retrieve node info and peer aliases.
+	{Entity: "info", Action: "read"},
+
+	// List channels and subscribe to htlc events.
+	{Entity: "offchain", Action: "read"},
+
+	// Register the htlc interceptor.
+	{Entity: "offchain", Action: "write"},
+}
+
+// checkPermissionsURI is the uri permission that allows circuitbreaker to check
+// the permissions of its own macaroon on startup, without granting the
+// macaroon:read permission that would expose other macaroons.
+var checkPermissionsURI = &lnrpc.MacaroonPermission{
+	Entity: "uri",
+	Action: "/lnrpc.Lightning/CheckMacaroonPermissions",
+}
+
+// bakedPermissions are the permissions of a macaroon baked by the bakemacaroon
+// command.
+var bakedPermissions = append(
+	[]*lnrpc.MacaroonPermission{checkPermissionsURI},
+	requiredPermissions...,
+)
+
+func formatPermissions(perms []*lnrpc.MacaroonPermission) string {
+	strs := make([]string, len(perms))
+	for i, perm := range perms {
+		strs[i] = perm.Entity + ":" + perm.Action
+	}
+
+	return strings.Join(strs, ", ")
+}
+
+var bakeMacaroonCommand = cli.Command{
+	Name:  "bakemacaroon",
+	Usage: "bake an lnd macaroon with only the permissions circuitbreaker needs",
+	Description: "Connects to lnd once using a macaroon that is allowed to " +
+		"bake new macaroons (admin.macaroon by default) and saves a new " +
+		"macaroon that only grants " + formatPermissions(bakedPermissions) +
+		". Use --macaroonpath to point circuitbreaker to the baked macaroon.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "saveto",
+			Usage: "path to save the baked macaroon to, defaults to " +
+				defaultBakedMacaroonFilename + " next to the macaroon " +
+				"that is used to bake it",
+		},
+	},
+	Action: bakeMacaroon,
+}
+
+func bakeMacaroon(c *cli.Context) error {
+	lndCfg, err := lndConfigFromCli(c)
+	if err != nil {
+		return err
+	}
+
+	lndClient, err := NewLndClient(lndCfg)
+	if err != nil {
+		return err
+	}
+	defer lndClient.Close()
+
+	macBytes, err := lndClient.bakeMacaroon(bakedPermissions)
+	if err != nil {
+		return fmt.Errorf("unable to bake macaroon: %w", err)
+	}
+
+	savePath := cleanAndExpandPath(c.String("saveto"))
+	switch {
+	case savePath != "":
+
+	// Without a macaroon file to put it next to, save the baked macaroon in
+	// the working directory.
+	case lndCfg.MacPath == "":
+		savePath = defaultBakedMacaroonFilename
+
+	default:
+		savePath = filepath.Join(
+			filepath.Dir(lndCfg.MacPath), defaultBakedMacaroonFilename,
+		)
+	}
+
+	if err := os.WriteFile(savePath, macBytes, 0600); err != nil {
+		return err
+	}
+
+	log.Infow("Macaroon saved",
+		"path", savePath,
+		"permissions", formatPermissions(bakedPermissions))
+
+	return nil
+}
@@ -0,0 +1,315 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/urfave/cli"
+)
+
+const (
+	defaultMetricsPeerLabels = 100
+
+	// otherPeersLabel is the peer label of peers that exceed the per-peer
+	// label cap.
+	otherPeersLabel = "other"
+
+	// allPeersLabel is the peer label of all peers if per-peer labels are
+	// disabled.
+	allPeersLabel = "all"
+)
+
+var metricsPeerLabelsFlag = cli.IntFlag{
+	Name: "metrics.peerlabels",
+	Usage: "maximum number of peers per node that get their own label " +
+		"in the metrics, further peers are aggregated under peer=" +
+		otherPeersLabel + ". Zero disables per-peer labels",
+	Value: defaultMetricsPeerLabels,
+}
+
+// peerLabeler assigns metric labels to peers. The first peers that are seen
+// get their own label, up to a maximum per node.
+type peerLabeler struct {
+	maxPeers int
+	labels   map[route.Vertex]map[route.Vertex]string
+	lock     sync.Mutex
+}
+
+func newPeerLabeler(maxPeers int) *peerLabeler {
+	return &peerLabeler{
+		maxPeers: maxPeers,
+		labels:   make(map[route.Vertex]map[route.Vertex]string),
+	}
+}
+
+// label returns the label of a peer of a node and whether the label is unique
+// to the peer.
+func (l *peerLabeler) label(node, peer route.Vertex) (string, bool) {
+	if l.maxPeers <= 0 {
+		return allPeersLabel, false
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	nodeLabels, ok := l.labels[node]
+	if !ok {
+		nodeLabels = make(map[route.Vertex]string)
+		l.labels[node] = nodeLabels
+	}
+
+	if label, ok := nodeLabels[peer]; ok {
+		return label, true
+	}
+
+	if len(nodeLabels) >= l.maxPeers {
+		return otherPeersLabel, false
+	}
+
+	label := peer.String()
+	nodeLabels[peer] = label
+
+	return label, true
+}
+
+// metrics contains the prometheus metrics of circuitbreaker.
+type metrics struct {
+	registry *prometheus.Registry
+	labeler  *peerLabeler
+
+	resolutions *prometheus.CounterVec
+	rejects     *prometheus.CounterVec
+	holdTime    *prometheus.HistogramVec
+	queueWait   *prometheus.HistogramVec
+}
+
+func newMetrics(peerLabels int) *metrics {
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+		labeler:  newPeerLabeler(peerLabels),
+		resolutions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "circuitbreaker_htlc_resolutions_total",
+			Help: "Number of forwarded htlcs that resolved.",
+		}, []string{"node", "peer", "outcome"}),
+		rejects: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "circuitbreaker_htlc_rejects_total",
+			Help: "Number of htlcs that were failed on intercept.",
+		}, []string{"node", "peer", "reason"}),
+		holdTime: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "circuitbreaker_htlc_hold_time_seconds",
+			Help: "Time between forwarding and resolution of htlcs.",
+			Buckets: []float64{
+				0.1, 0.5, 1, 5, 10, 30, 60, 300, 3600, 86400,
+			},
+		}, []string{"node"}),
+		queueWait: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "circuitbreaker_htlc_queue_wait_seconds",
+			Help: "Time that queued htlcs spent in the queue.",
+			Buckets: []float64{
+				1, 5, 10, 30, 60, 300, 900, 3600,
+			},
+		}, []string{"node"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(
+			collectors.ProcessCollectorOpts{},
+		),
+		m.resolutions, m.rejects, m.holdTime, m.queueWait,
+	)
+
+	return m
+}
+
+// observe updates the counters and histograms with a decision of a node.
+func (m *metrics) observe(node route.Vertex, decision *htlcDecision) {
+	nodeLabel := node.String()
+	peer, _ := m.labeler.label(node, decision.incomingPeer)
+
+	switch decision.decision {
+	case decisionForward:
+		if decision.dequeued {
+			m.queueWait.WithLabelValues(nodeLabel).Observe(
+				decision.queueWait.Seconds(),
+			)
+		}
+
+	case decisionReject:
+		var reason string
+		switch decision.reason {
+		case reasonPendingLimit:
+			reason = "pending_limit"
+
+		case reasonRateLimit:
+			reason = "rate_limit"
+
+		case reasonQueueNotEmpty:
+			reason = "queue_not_empty"
+
+		case reasonOperator:
+			reason = "operator"
+		}
+
+		m.rejects.WithLabelValues(nodeLabel, peer, reason).Inc()
+
+	case decisionBlock:
+		m.rejects.WithLabelValues(nodeLabel, peer, "blocked").Inc()
+
+	case decisionResolve:
+		outcome := "fail"
+		if decision.settled {
+			outcome = "settle"
+		}
+
+		m.resolutions.WithLabelValues(nodeLabel, peer, outcome).Inc()
+
+		if decision.holdTime > 0 {
+			m.holdTime.WithLabelValues(nodeLabel).Observe(
+				decision.holdTime.Seconds(),
+			)
+		}
+	}
+}
+
+var (
+	pendingHtlcsDesc = prometheus.NewDesc(
+		"circuitbreaker_pending_htlcs",
+		"Number of htlcs that are in flight.",
+		[]string{"node", "peer"}, nil,
+	)
+
+	queueLengthDesc = prometheus.NewDesc(
+		"circuitbreaker_queue_length",
+		"Number of htlcs that are queued.",
+		[]string{"node", "peer"}, nil,
+	)
+
+	limitMaxPendingDesc = prometheus.NewDesc(
+		"circuitbreaker_limit_max_pending",
+		"Maximum number of pending htlcs, zero if unlimited.",
+		[]string{"node", "peer"}, nil,
+	)
+
+	limitMaxHourlyRateDesc = prometheus.NewDesc(
+		"circuitbreaker_limit_max_hourly_rate",
+		"Maximum number of htlcs per hour, zero if unlimited.",
+		[]string{"node", "peer"}, nil,
+	)
+
+	limitModeDesc = prometheus.NewDesc(
+		"circuitbreaker_limit_mode",
+		"The mode of the limit, always 1.",
+		[]string{"node", "peer", "mode"}, nil,
+	)
+
+	limiterTokensDesc = prometheus.NewDesc(
+		"circuitbreaker_limiter_tokens",
+		"Number of htlcs that the rate limiter currently allows.",
+		[]string{"node", "peer"}, nil,
+	)
+)
+
+// peerCollector collects the per-peer gauges from the processes of the nodes
+// when metrics are scraped. The limits and limiter tokens are only reported
+// for peers that have their own label.
+type peerCollector struct {
+	nodes   []*lndNode
+	labeler *peerLabeler
+}
+
+func (c *peerCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- pendingHtlcsDesc
+	ch <- queueLengthDesc
+	ch <- limitMaxPendingDesc
+	ch <- limitMaxHourlyRateDesc
+	ch <- limitModeDesc
+	ch <- limiterTokensDesc
+}
+
+func (c *peerCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, node := range c.nodes {
+		c.collectNode(ch, node)
+	}
+}
+
+func (c *peerCollector) collectNode(ch chan<- prometheus.Metric,
+	node *lndNode) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	states, err := node.process.getRateCounters(ctx)
+	if err != nil {
+		log.Infow("Cannot collect peer metrics", "lndNode", node.key,
+			"err", err)
+
+		return
+	}
+
+	nodeLabel := node.key.String()
+
+	type totals struct {
+		pending, queued int64
+	}
+	aggregated := make(map[string]*totals)
+
+	for peer, state := range states {
+		label, unique := c.labeler.label(node.key, peer)
+
+		total, ok := aggregated[label]
+		if !ok {
+			total = &totals{}
+			aggregated[label] = total
+		}
+		total.pending += state.pendingHtlcCount
+		total.queued += state.queueLen
+
+		if !unique {
+			continue
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			limitMaxPendingDesc, prometheus.GaugeValue,
+			float64(state.limit.MaxPending), nodeLabel, label,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			limitMaxHourlyRateDesc, prometheus.GaugeValue,
+			float64(state.limit.MaxHourlyRate), nodeLabel, label,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			limitModeDesc, prometheus.GaugeValue, 1, nodeLabel,
+			label, state.limit.Mode.String(),
+		)
+
+		// Without a rate limit, there are no tokens to report.
+		if state.limit.MaxHourlyRate > 0 {
+			ch <- prometheus.MustNewConstMetric(
+				limiterTokensDesc, prometheus.GaugeValue,
+				state.tokens, nodeLabel, label,
+			)
+		}
+	}
+
+	for label, total := range aggregated {
+		ch <- prometheus.MustNewConstMetric(
+			pendingHtlcsDesc, prometheus.GaugeValue,
+			float64(total.pending), nodeLabel, label,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			queueLengthDesc, prometheus.GaugeValue,
+			float64(total.queued), nodeLabel, label,
+		)
+	}
+}
+
+// registerNodes adds the per-peer gauges of the nodes provided.
+func (m *metrics) registerNodes(nodes []*lndNode) {
+	m.registry.MustRegister(&peerCollector{
+		nodes:   nodes,
+		labeler: m.labeler,
+	})
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestPeerLabeler(t *testing.T) {
+	node1, node2 := route.Vertex{100}, route.Vertex{101}
+	peer1, peer2 := route.Vertex{1}, route.Vertex{2}
+
+	labeler := newPeerLabeler(1)
+
+	label, unique := labeler.label(node1, peer1)
+	require.Equal(t, peer1.String(), label)
+	require.True(t, unique)
+
+	// The cap applies per node.
+	label, unique = labeler.label(node1, peer2)
+	require.Equal(t, otherPeersLabel, label)
+	require.False(t, unique)
+
+	label, unique = labeler.label(node2, peer2)
+	require.Equal(t, peer2.String(), label)
+	require.True(t, unique)
+
+	// Peers keep their label.
+	label, unique = labeler.label(node1, peer1)
+	require.Equal(t, peer1.String(), label)
+	require.True(t, unique)
+
+	label, unique = newPeerLabeler(0).label(node1, peer1)
+	require.Equal(t, allPeersLabel, label)
+	require.False(t, unique)
+}
+
+// gaugeValue returns the value of a gauge in the registry of the metrics
+// provided.
+func gaugeValue(t *testing.T, m *metrics, name, peer string) float64 {
+	families, err := m.registry.Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+
+		for _, metric := range family.Metric {
+			for _, label := range metric.Label {
+				if label.GetName() == "peer" &&
+					label.GetValue() == peer {
+
+					return metric.Gauge.GetValue()
+				}
+			}
+		}
+	}
+
+	t.Fatalf("gauge %v for peer %v not found", name, peer)
+
+	return 0
+}
+
+func TestMetrics(t *testing.T) {
+	defer Timeout()()
+
+	client := newLndclientMock(testChannels, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db, cleanup := setupTestDb(t, defaultFwdHistoryLimit)
+	defer cleanup()
+
+	log := zaptest.NewLogger(t).Sugar()
+
+	cfg := &Limits{
+		PerPeer: map[route.Vertex]Limit{
+			{2}: {
+				MaxHourlyRate: 60,
+				MaxPending:    1,
+			},
+			{3}: {
+				Mode: ModeBlock,
+			},
+		},
+	}
+
+	m := newMetrics(defaultMetricsPeerLabels)
+
+	p := NewProcess(client, log, cfg, db)
+	p.metrics = m
+
+	m.registerNodes([]*lndNode{newLndNode(mockIdentity, client, p)})
+
+	resolved := make(chan struct{})
+	p.resolvedCallback = func() {
+		close(resolved)
+	}
+
+	exit := make(chan error)
+	go func() {
+		exit <- p.Run(ctx)
+	}()
+
+	intercept := func(key circuitKey, expectResume bool) {
+		client.htlcInterceptorRequests <- &interceptedEvent{
+			circuitKey: key,
+		}
+
+		resp := <-client.htlcInterceptorResponses
+		require.Equal(t, expectResume, resp.resume)
+	}
+
+	key := circuitKey{channel: 2, htlc: 5}
+	intercept(key, true)
+	intercept(circuitKey{channel: 2, htlc: 6}, false)
+	intercept(circuitKey{channel: 3, htlc: 1}, false)
+
+	node := mockIdentity.String()
+	peer2, peer3 := route.Vertex{2}.String(), route.Vertex{3}.String()
+
+	// The gauges reflect the state of the peer controllers. Collecting
+	// them also waits for the controllers to finish the decisions.
+	require.EqualValues(t, 1, gaugeValue(
+		t, m, "circuitbreaker_pending_htlcs", peer2,
+	))
+	require.EqualValues(t, 1, gaugeValue(
+		t, m, "circuitbreaker_limit_max_pending", peer2,
+	))
+	require.EqualValues(t, 60, gaugeValue(
+		t, m, "circuitbreaker_limit_max_hourly_rate", peer2,
+	))
+
+	require.EqualValues(t, 1, testutil.ToFloat64(
+		m.rejects.WithLabelValues(node, peer2, "pending_limit"),
+	))
+	require.EqualValues(t, 1, testutil.ToFloat64(
+		m.rejects.WithLabelValues(node, peer3, "blocked"),
+	))
+
+	client.htlcEvents <- &resolvedEvent{
+		incomingCircuitKey: key,
+		outgoingCircuitKey: outgoingKey,
+		settled:            true,
+	}
+	<-resolved
+
+	require.EqualValues(t, 0, gaugeValue(
+		t, m, "circuitbreaker_pending_htlcs", peer2,
+	))
+	require.EqualValues(t, 1, testutil.ToFloat64(
+		m.resolutions.WithLabelValues(node, peer2, "settle"),
+	))
+
+	cancel()
+	require.ErrorIs(t, <-exit, context.Canceled)
+}
@@ -0,0 +1,519 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/urfave/cli"
+)
+
+const (
+	defaultWebhookDebounce       = 10 * time.Minute
+	defaultWebhookQueueThreshold = 10
+	defaultWebhookRejectBurst    = 100
+
+	// rejectBurstWindow is the window in which rejects are counted
+	// towards a reject burst.
+	rejectBurstWindow = time.Minute
+
+	// webhookQueueSize is the number of notifications that are buffered
+	// per webhook. Further notifications are dropped while a webhook is
+	// unreachable.
+	webhookQueueSize = 100
+
+	webhookTimeout         = 10 * time.Second
+	webhookMaxAttempts     = 5
+	webhookRetryBackoff    = 2 * time.Second
+	webhookFlushTimeout    = 5 * time.Second
+	webhookSignatureHeader = "X-Circuitbreaker-Signature"
+)
+
+var (
+	webhookURLFlag = cli.StringSliceFlag{
+		Name:  "webhook.url",
+		Usage: "url that notifications are posted to, can be repeated",
+	}
+
+	webhookSecretFlag = cli.StringFlag{
+		Name: "webhook.secret",
+		Usage: "key of the hmac-sha256 signature of the notifications, " +
+			"sent in the " + webhookSignatureHeader + " header",
+		EnvVar: "CIRCUITBREAKER_WEBHOOK_SECRET",
+	}
+
+	webhookEventsFlag = cli.StringSliceFlag{
+		Name: "webhook.event",
+		Usage: "event to notify about, can be repeated. Defaults to all " +
+			"events",
+	}
+
+	webhookDebounceFlag = cli.DurationFlag{
+		Name: "webhook.debounce",
+		Usage: "minimum time between notifications of the same event " +
+			"for the same peer",
+		Value: defaultWebhookDebounce,
+	}
+
+	webhookQueueThresholdFlag = cli.IntFlag{
+		Name: "webhook.queuethreshold",
+		Usage: "queue length of a peer above which " +
+			string(eventQueueThreshold) + " is notified. Zero " +
+			"disables the event",
+		Value: defaultWebhookQueueThreshold,
+	}
+
+	webhookRejectBurstFlag = cli.IntFlag{
+		Name: "webhook.rejectburst",
+		Usage: "number of htlcs rejected by a node within a minute " +
+			"that is notified as " + string(eventRejectBurst) +
+			". Zero disables the event",
+		Value: defaultWebhookRejectBurst,
+	}
+)
+
+type notificationEvent string
+
+const (
+	// eventPendingLimit is sent when an htlc is rejected or queued
+	// because its peer reached the pending limit.
+	eventPendingLimit notificationEvent = "pending_limit"
+
+	// eventQueueThreshold is sent when the queue of a peer exceeds the
+	// threshold.
+	eventQueueThreshold notificationEvent = "queue_threshold"
+
+	// eventRejectBurst is sent when a node rejects many htlcs in a short
+	// time.
+	eventRejectBurst notificationEvent = "reject_burst"
+
+	// eventLndStreamError is sent when the htlc event or interceptor stream
+	// of lnd fails. Circuitbreaker exits after this event.
+	eventLndStreamError notificationEvent = "lnd_stream_error"
+
+	// eventDbError is sent when a resolved htlc can't be stored.
+	eventDbError notificationEvent = "db_error"
+)
+
+var notificationEvents = []notificationEvent{
+	eventPendingLimit, eventQueueThreshold, eventRejectBurst,
+	eventLndStreamError, eventDbError,
+}
+
+// notification is the json payload that is posted to the webhooks.
+type notification struct {
+	Event   notificationEvent `json:"event"`
+	Time    time.Time         `json:"time"`
+	Node    string            `json:"node"`
+	Peer    string            `json:"peer,omitempty"`
+	Message string            `json:"message"`
+
+	// Details contains event specific values.
+	Details map[string]int64 `json:"details,omitempty"`
+
+	// Suppressed is the number of notifications of the same event for the
+	// same peer that were debounced since the previous notification.
+	Suppressed int `json:"suppressed,omitempty"`
+}
+
+type notifierConfig struct {
+	urls           []string
+	secret         string
+	events         []notificationEvent
+	debounce       time.Duration
+	queueThreshold int
+	rejectBurst    int
+}
+
+func notifierConfigFromCli(c *cli.Context) (*notifierConfig, error) {
+	cfg := &notifierConfig{
+		urls:           c.StringSlice(webhookURLFlag.Name),
+		secret:         c.String(webhookSecretFlag.Name),
+		debounce:       c.Duration(webhookDebounceFlag.Name),
+		queueThreshold: c.Int(webhookQueueThresholdFlag.Name),
+		rejectBurst:    c.Int(webhookRejectBurstFlag.Name),
+	}
+
+	for _, name := range c.StringSlice(webhookEventsFlag.Name) {
+		event, err := parseNotificationEvent(name)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.events = append(cfg.events, event)
+	}
+
+	if cfg.debounce < 0 || cfg.queueThreshold < 0 || cfg.rejectBurst < 0 {
+		return nil, fmt.Errorf("%v, %v and %v cannot be negative",
+			webhookDebounceFlag.Name, webhookQueueThresholdFlag.Name,
+			webhookRejectBurstFlag.Name)
+	}
+
+	return cfg, nil
+}
+
+func parseNotificationEvent(name string) (notificationEvent, error) {
+	for _, event := range notificationEvents {
+		if string(event) == name {
+			return event, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown webhook event %v", name)
+}
+
+// debounceKey identifies the notifications that are debounced together.
+type debounceKey struct {
+	event notificationEvent
+	node  route.Vertex
+	peer  route.Vertex
+}
+
+type debounceState struct {
+	lastSent   time.Time
+	suppressed int
+}
+
+// notifier turns htlc decisions and errors into notifications and posts them
+// to the configured webhooks. Notifying never blocks, so that unreachable
+// webhooks can't hold up htlcs.
+type notifier struct {
+	cfg    *notifierConfig
+	events map[notificationEvent]bool
+	client *http.Client
+	now    func() time.Time
+
+	// retryBackoff is the delay before the first retry of a failed
+	// delivery. It doubles with every further attempt.
+	retryBackoff time.Duration
+
+	debounced map[debounceKey]*debounceState
+	rejects   map[route.Vertex][]time.Time
+	stopped   bool
+	lock      sync.Mutex
+
+	webhooks []chan *notification
+	wg       sync.WaitGroup
+	cancel   context.CancelFunc
+}
+
+func newNotifier(cfg *notifierConfig) *notifier {
+	events := make(map[notificationEvent]bool)
+	if len(cfg.events) == 0 {
+		cfg.events = notificationEvents
+	}
+	for _, event := range cfg.events {
+		events[event] = true
+	}
+
+	return &notifier{
+		cfg:    cfg,
+		events: events,
+		client: &http.Client{
+			Timeout: webhookTimeout,
+		},
+		now:          time.Now,
+		retryBackoff: webhookRetryBackoff,
+		debounced:    make(map[debounceKey]*debounceState),
+		rejects:      make(map[route.Vertex][]time.Time),
+	}
+}
+
+// start launches a delivery goroutine per webhook. Deliveries use their own
+// context, so that notifications about a failure that shuts down
+// circuitbreaker still go out.
+func (n *notifier) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	n.cancel = cancel
+
+	for _, url := range n.cfg.urls {
+		queue := make(chan *notification, webhookQueueSize)
+		n.webhooks = append(n.webhooks, queue)
+
+		url := url
+		n.wg.Add(1)
+		go func() {
+			defer n.wg.Done()
+
+			for msg := range queue {
+				n.deliver(ctx, url, msg)
+			}
+		}()
+	}
+}
+
+// stop delivers the pending notifications and stops the delivery goroutines.
+// Deliveries that don't complete in time are abandoned.
+func (n *notifier) stop() {
+	n.lock.Lock()
+	n.stopped = true
+	for _, queue := range n.webhooks {
+		close(queue)
+	}
+	n.lock.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		n.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+
+	case <-time.After(webhookFlushTimeout):
+		log.Infow("Abandoning undelivered webhook notifications")
+		n.cancel()
+		<-done
+	}
+
+	n.cancel()
+}
+
+// notify queues a notification for all webhooks, unless the same event for
+// the same peer was notified recently.
+func (n *notifier) notify(key debounceKey, msg *notification) {
+	if !n.events[key.event] {
+		return
+	}
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if n.stopped {
+		return
+	}
+
+	now := n.now()
+	state, ok := n.debounced[key]
+	if !ok {
+		state = &debounceState{}
+		n.debounced[key] = state
+	}
+
+	if !state.lastSent.IsZero() &&
+		now.Sub(state.lastSent) < n.cfg.debounce {
+
+		state.suppressed++
+
+		return
+	}
+
+	msg.Event = key.event
+	msg.Time = now
+	msg.Node = key.node.String()
+	if key.peer != (route.Vertex{}) {
+		msg.Peer = key.peer.String()
+	}
+	msg.Suppressed = state.suppressed
+
+	state.lastSent = now
+	state.suppressed = 0
+
+	for i, queue := range n.webhooks {
+		select {
+		case queue <- msg:
+
+		default:
+			log.Infow("Webhook queue full, dropping notification",
+				"url", n.cfg.urls[i], "event", msg.Event)
+		}
+	}
+}
+
+// observe derives notifications from a decision of a node.
+func (n *notifier) observe(node route.Vertex, decision *htlcDecision) {
+	peer := decision.incomingPeer
+
+	switch decision.decision {
+	case decisionQueue:
+		if decision.reason == reasonPendingLimit {
+			n.notify(debounceKey{eventPendingLimit, node, peer},
+				&notification{
+					Message: "peer reached its pending " +
+						"limit, htlc queued",
+				})
+		}
+
+		if n.cfg.queueThreshold > 0 &&
+			decision.queuePosition > n.cfg.queueThreshold {
+
+			n.notify(debounceKey{eventQueueThreshold, node, peer},
+				&notification{
+					Message: "queue length exceeds " +
+						"threshold",
+					Details: map[string]int64{
+						"queue_length": int64(
+							decision.queuePosition,
+						),
+						"threshold": int64(
+							n.cfg.queueThreshold,
+						),
+					},
+				})
+		}
+
+	case decisionReject, decisionBlock:
+		// Htlcs that are failed by an operator are expected and don't
+		// count towards a burst.
+		if decision.reason == reasonOperator {
+			return
+		}
+
+		if decision.reason == reasonPendingLimit {
+			n.notify(debounceKey{eventPendingLimit, node, peer},
+				&notification{
+					Message: "peer reached its pending " +
+						"limit, htlc rejected",
+				})
+		}
+
+		n.observeReject(node)
+	}
+}
+
+// observeReject counts the rejects of a node in the burst window.
+func (n *notifier) observeReject(node route.Vertex) {
+	if n.cfg.rejectBurst == 0 {
+		return
+	}
+
+	now := n.now()
+
+	n.lock.Lock()
+	rejects := n.rejects[node]
+
+	// Drop the rejects that fell out of the window.
+	start := 0
+	for start < len(rejects) &&
+		now.Sub(rejects[start]) >= rejectBurstWindow {
+
+		start++
+	}
+	rejects = append(rejects[start:], now)
+	n.rejects[node] = rejects
+	count := len(rejects)
+	n.lock.Unlock()
+
+	if count < n.cfg.rejectBurst {
+		return
+	}
+
+	n.notify(debounceKey{event: eventRejectBurst, node: node},
+		&notification{
+			Message: "node rejected many htlcs",
+			Details: map[string]int64{
+				"rejects":        int64(count),
+				"window_seconds": int64(rejectBurstWindow.Seconds()),
+			},
+		})
+}
+
+// lndStreamError reports the failure of an lnd stream of a node.
+func (n *notifier) lndStreamError(node route.Vertex, err error) {
+	n.notify(debounceKey{event: eventLndStreamError, node: node},
+		&notification{
+			Message: err.Error(),
+		})
+}
+
+// dbError reports the failure to store a resolved htlc.
+func (n *notifier) dbError(node, peer route.Vertex, err error) {
+	n.notify(debounceKey{event: eventDbError, node: node},
+		&notification{
+			Peer:    peer.String(),
+			Message: fmt.Sprintf("cannot store htlc resolution: %v", err),
+		})
+}
+
+// sign returns the signature header value of a payload.
+func (n *notifier) sign(payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(n.cfg.secret))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// errPermanent marks delivery errors that aren't retried.
+var errPermanent = errors.New("permanent error")
+
+// post makes a single delivery attempt.
+func (n *notifier) post(ctx context.Context, url string, payload []byte) error {
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, url, bytes.NewReader(payload),
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errPermanent, err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if n.cfg.secret != "" {
+		req.Header.Set(webhookSignatureHeader, n.sign(payload))
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+
+	// Server errors and rate limiting are temporary, other client errors
+	// won't go away by retrying.
+	case resp.StatusCode >= 500 ||
+		resp.StatusCode == http.StatusTooManyRequests:
+
+		return fmt.Errorf("status %v", resp.StatusCode)
+
+	default:
+		return fmt.Errorf("%w: status %v", errPermanent, resp.StatusCode)
+	}
+}
+
+// deliver posts a notification to a webhook, retrying with exponential
+// backoff.
+func (n *notifier) deliver(ctx context.Context, url string,
+	msg *notification) {
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		log.Errorw("Cannot encode notification", "err", err)
+
+		return
+	}
+
+	backoff := n.retryBackoff
+	for attempt := 1; ; attempt++ {
+		err := n.post(ctx, url, payload)
+		if err == nil {
+			return
+		}
+
+		if errors.Is(err, errPermanent) || attempt == webhookMaxAttempts {
+			log.Infow("Webhook delivery failed", "url", url,
+				"event", msg.Event, "attempts", attempt, "err", err)
+
+			return
+		}
+
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// webhookStandIn is a local http server that records the notifications that
+// it receives. The first requests fail with the status codes provided.
+type webhookStandIn struct {
+	server   *httptest.Server
+	failures []int
+
+	received chan *http.Request
+	bodies   chan []byte
+	lock     sync.Mutex
+}
+
+func newWebhookStandIn(failures ...int) *webhookStandIn {
+	w := &webhookStandIn{
+		failures: failures,
+		received: make(chan *http.Request, 100),
+		bodies:   make(chan []byte, 100),
+	}
+
+	w.server = httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, r *http.Request) {
+			w.lock.Lock()
+			var status int
+			if len(w.failures) > 0 {
+				status = w.failures[0]
+				w.failures = w.failures[1:]
+			}
+			w.lock.Unlock()
+
+			if status != 0 {
+				rw.WriteHeader(status)
+
+				return
+			}
+
+			body, _ := io.ReadAll(r.Body)
+			w.received <- r
+			w.bodies <- body
+		},
+	))
+
+	return w
+}
+
+// next returns the next notification that was delivered successfully.
+func (w *webhookStandIn) next(t *testing.T) (*http.Request, []byte,
+	*notification) {
+
+	select {
+	case r := <-w.received:
+		body := <-w.bodies
+
+		var msg notification
+		require.NoError(t, json.Unmarshal(body, &msg))
+
+		return r, body, &msg
+
+	case <-time.After(5 * time.Second):
+		t.Fatal("no notification received")
+
+		return nil, nil, nil
+	}
+}
+
+func (w *webhookStandIn) requireEmpty(t *testing.T) {
+	select {
+	case <-w.received:
+		t.Fatal("unexpected notification")
+
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestWebhookDelivery(t *testing.T) {
+	// The stand-in fails twice before accepting the notification.
+	standIn := newWebhookStandIn(
+		http.StatusServiceUnavailable, http.StatusTooManyRequests,
+	)
+	defer standIn.server.Close()
+
+	n := newNotifier(&notifierConfig{
+		urls:   []string{standIn.server.URL},
+		secret: "secret",
+	})
+	n.retryBackoff = time.Millisecond
+	n.start()
+
+	node, peer := route.Vertex{1}, route.Vertex{2}
+	n.dbError(node, peer, io.ErrUnexpectedEOF)
+
+	r, body, msg := standIn.next(t)
+	require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+	require.Equal(t, n.sign(body), r.Header.Get(webhookSignatureHeader))
+	require.Equal(t, eventDbError, msg.Event)
+	require.Equal(t, node.String(), msg.Node)
+	require.Equal(t, peer.String(), msg.Peer)
+	require.Contains(t, msg.Message, io.ErrUnexpectedEOF.Error())
+
+	// Client errors are not retried.
+	standIn.lock.Lock()
+	standIn.failures = []int{http.StatusBadRequest}
+	standIn.lock.Unlock()
+	n.lndStreamError(node, io.EOF)
+	standIn.requireEmpty(t)
+
+	// Pending notifications are delivered on stop.
+	n.lndStreamError(route.Vertex{3}, io.EOF)
+	n.stop()
+
+	_, _, msg = standIn.next(t)
+	require.Equal(t, eventLndStreamError, msg.Event)
+
+	// Notifications after stop are dropped.
+	n.lndStreamError(route.Vertex{4}, io.EOF)
+	standIn.requireEmpty(t)
+}
+
+func TestNotifierEvents(t *testing.T) {
+	standIn := newWebhookStandIn()
+	defer standIn.server.Close()
+
+	n := newNotifier(&notifierConfig{
+		urls:           []string{standIn.server.URL},
+		events:         notificationEvents,
+		debounce:       time.Minute,
+		queueThreshold: 2,
+		rejectBurst:    3,
+	})
+
+	now := time.Unix(1000, 0)
+	n.now = func() time.Time { return now }
+
+	n.start()
+	defer n.stop()
+
+	node, peer := route.Vertex{1}, route.Vertex{2}
+
+	decide := func(decision decisionType, reason rejectReason,
+		queuePosition int) {
+
+		n.observe(node, &htlcDecision{
+			decision:      decision,
+			reason:        reason,
+			incomingPeer:  peer,
+			queuePosition: queuePosition,
+		})
+	}
+
+	// Hitting the pending limit is notified once within the debounce
+	// period.
+	decide(decisionQueue, reasonPendingLimit, 1)
+	decide(decisionQueue, reasonPendingLimit, 2)
+
+	_, _, msg := standIn.next(t)
+	require.Equal(t, eventPendingLimit, msg.Event)
+	require.Equal(t, peer.String(), msg.Peer)
+	standIn.requireEmpty(t)
+
+	// Exceeding the queue threshold is notified.
+	decide(decisionQueue, reasonQueueNotEmpty, 3)
+
+	_, _, msg = standIn.next(t)
+	require.Equal(t, eventQueueThreshold, msg.Event)
+	require.EqualValues(t, 3, msg.Details["queue_length"])
+
+	// After the debounce period, the number of suppressed notifications is
+	// reported.
+	now = now.Add(time.Minute)
+	decide(decisionReject, reasonPendingLimit, 0)
+
+	_, _, msg = standIn.next(t)
+	require.Equal(t, eventPendingLimit, msg.Event)
+	require.Equal(t, 1, msg.Suppressed)
+
+	// Rejects that fall out of the window don't count towards a burst.
+	now = now.Add(rejectBurstWindow)
+	decide(decisionBlock, reasonNone, 0)
+	decide(decisionReject, reasonRateLimit, 0)
+	standIn.requireEmpty(t)
+
+	decide(decisionReject, reasonRateLimit, 0)
+
+	_, _, msg = standIn.next(t)
+	require.Equal(t, eventRejectBurst, msg.Event)
+	require.Empty(t, msg.Peer)
+	require.EqualValues(t, 3, msg.Details["rejects"])
+}
+
+func TestNotifierEventFilter(t *testing.T) {
+	standIn := newWebhookStandIn()
+	defer standIn.server.Close()
+
+	n := newNotifier(&notifierConfig{
+		urls:   []string{standIn.server.URL},
+		events: []notificationEvent{eventDbError},
+	})
+	n.start()
+	defer n.stop()
+
+	n.lndStreamError(route.Vertex{1}, io.EOF)
+	standIn.requireEmpty(t)
+
+	_, err := parseNotificationEvent("unknown")
+	require.Error(t, err)
+}
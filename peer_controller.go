@@ -3,6 +3,9 @@ package main
 import (
 	"container/list"
 	"context"
+	"errors"
+	"fmt"
+	"sort"
 	"time"
 
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -12,6 +15,10 @@ import (
 	"golang.org/x/time/rate"
 )
 
+// errQueuedHtlcNotFound is returned for operator actions on an htlc that is
+// not queued.
+var errQueuedHtlcNotFound = errors.New("htlc not queued")
+
 type eventCounter struct {
 	fail    *ratecounter.RateCounter
 	success *ratecounter.RateCounter
@@ -50,6 +57,13 @@ func (e *eventCounter) Incr(event eventType) {
 	}
 }
 
+// add adds counts that were restored from a previous run.
+func (e *eventCounter) add(counts rateCounts) {
+	e.success.Incr(counts.success)
+	e.fail.Incr(counts.fail)
+	e.reject.Incr(counts.reject)
+}
+
 func (e *eventCounter) Rates() (int64, int64, int64) {
 	return e.success.Rate(), e.fail.Rate(), e.reject.Rate()
 }
@@ -61,7 +75,8 @@ type peerController struct {
 	interceptChan   chan peerInterceptEvent
 	resolvedChan    chan peerResolvedEvent
 	updateLimitChan chan Limit
-	getStateChan    chan chan *peerState
+	getStateChan    chan stateRequest
+	queueActionChan chan *queueActionRequest
 
 	rateCounters []*eventCounter
 
@@ -72,18 +87,28 @@ type peerController struct {
 	lnd             lndclient
 	now             func() time.Time
 	htlcCompleted   func(context.Context, *HtlcInfo) error
+	decided         func(*htlcDecision)
+
+	interceptorRequired bool
 }
 
 type inFlightHtlc struct {
 	addedTs      time.Time
 	incomingMsat lnwire.MilliSatoshi
 	outgoingMsat lnwire.MilliSatoshi
+
+	// held indicates that the htlc was pending on startup, but not yet
+	// forwarded by lnd.
+	held bool
 }
 
 type peerInterceptEvent struct {
 	interceptEvent
 
 	peerInitiated bool
+
+	// queuedAt is the time at which the htlc was queued.
+	queuedAt time.Time
 }
 
 type peerResolvedEvent struct {
@@ -95,6 +120,97 @@ type peerState struct {
 	counts           []rateCounts
 	queueLen         int64
 	pendingHtlcCount int64
+	limit            Limit
+
+	// tokens is the number of htlcs that the rate limiter currently
+	// allows.
+	tokens float64
+
+	// pendingHtlcs and queuedHtlcs are only set for detailed states. Queued
+	// htlcs are ordered from the next htlc to be forwarded to the last.
+	pendingHtlcs []*htlcSnapshot
+	queuedHtlcs  []*htlcSnapshot
+}
+
+// htlcSnapshot describes an htlc that is pending or queued at the time the
+// state of a peer controller is requested.
+type htlcSnapshot struct {
+	circuitKey
+
+	// since is the time at which the htlc was forwarded or queued. It is
+	// zero for htlcs that were already pending on startup.
+	since time.Time
+
+	incomingMsat lnwire.MilliSatoshi
+	outgoingMsat lnwire.MilliSatoshi
+
+	// outgoingChannel is only known for queued htlcs.
+	outgoingChannel uint64
+}
+
+// stateRequest requests the state of a peer controller. Detailed states
+// include the htlcs that are pending and queued.
+type stateRequest struct {
+	detailed bool
+	resp     chan *peerState
+}
+
+// queueAction is an operator action on queued htlcs.
+type queueAction int
+
+const (
+	queueActionForward queueAction = iota
+	queueActionFail
+)
+
+func (a queueAction) String() string {
+	switch a {
+	case queueActionForward:
+		return "forward"
+
+	case queueActionFail:
+		return "fail"
+
+	default:
+		return "unknown"
+	}
+}
+
+func parseQueueAction(str string) (queueAction, error) {
+	switch str {
+	case "forward":
+		return queueActionForward, nil
+
+	case "fail":
+		return queueActionFail, nil
+
+	default:
+		return 0, fmt.Errorf("unknown queue action %v", str)
+	}
+}
+
+// queueActionRequest applies an operator action to a single queued htlc, or to
+// all queued htlcs of a peer if no key is set.
+type queueActionRequest struct {
+	action queueAction
+
+	// key selects the queued htlc. The peer is derived from its channel.
+	key *circuitKey
+
+	// peer selects the queue to flush if no key is set.
+	peer route.Vertex
+
+	// resp receives the result. It must be buffered, so that the peer
+	// controller never blocks on it.
+	resp chan *queueActionResult
+}
+
+// queueActionResult lists the htlcs that an operator action resolved. If an
+// error occurred, the htlcs that were resolved before it are still listed.
+type queueActionResult struct {
+	peer  route.Vertex
+	htlcs []*htlcSnapshot
+	err   error
 }
 
 type rateCounts struct {
@@ -112,6 +228,21 @@ type peerControllerCfg struct {
 	pubKey        route.Vertex
 	now           func() time.Time
 	htlcCompleted func(context.Context, *HtlcInfo) error
+
+	// decided is called for every decision on an htlc and every
+	// resolution. It must not block.
+	decided func(*htlcDecision)
+
+	// counts are rate counts to start from, in the order of
+	// rateCounterIntervals. They are restored from a previous run and may
+	// be nil.
+	counts []rateCounts
+
+	// interceptorRequired indicates that lnd holds htlcs while no
+	// interceptor is connected. Pending htlcs that lnd hadn't forwarded on
+	// startup and that are intercepted again are then held htlcs that still
+	// need a decision rather than replays.
+	interceptorRequired bool
 }
 
 func newPeerController(cfg *peerControllerCfg) *peerController {
@@ -135,6 +266,10 @@ func newPeerController(cfg *peerControllerCfg) *peerController {
 	rateCounters := make([]*eventCounter, len(rateCounterIntervals))
 	for idx, interval := range rateCounterIntervals {
 		rateCounters[idx] = newEventCounter(interval)
+
+		if idx < len(cfg.counts) {
+			rateCounters[idx].add(cfg.counts[idx])
+		}
 	}
 
 	return &peerController{
@@ -144,7 +279,8 @@ func newPeerController(cfg *peerControllerCfg) *peerController {
 		interceptChan:   make(chan peerInterceptEvent),
 		resolvedChan:    make(chan peerResolvedEvent),
 		updateLimitChan: make(chan Limit),
-		getStateChan:    make(chan chan *peerState),
+		getStateChan:    make(chan stateRequest),
+		queueActionChan: make(chan *queueActionRequest),
 		htlcs:           cfg.htlcs,
 		rateCounters:    rateCounters,
 		lnd:             cfg.lnd,
@@ -152,13 +288,18 @@ func newPeerController(cfg *peerControllerCfg) *peerController {
 		lastChannelSync: cfg.now(),
 		now:             cfg.now,
 		htlcCompleted:   cfg.htlcCompleted,
+		decided:         cfg.decided,
+
+		interceptorRequired: cfg.interceptorRequired,
 	}
 }
 
-func (p *peerController) state(ctx context.Context) (*peerState, error) {
+func (p *peerController) state(ctx context.Context, detailed bool) (
+	*peerState, error) {
+
 	respChan := make(chan *peerState)
 	select {
-	case p.getStateChan <- respChan:
+	case p.getStateChan <- stateRequest{detailed: detailed, resp: respChan}:
 	case <-ctx.Done():
 		return nil, ctx.Err()
 	}
@@ -282,9 +423,20 @@ func (p *peerController) run(ctx context.Context) error {
 
 			// Replays can happen when the htlcs map is initialized with a
 			// pending htlc on startup, and then a forward event happens for
-			// that htlc. For those htlcs, just resume.
-			_, ok := p.htlcs[event.circuitKey]
-			if ok {
+			// that htlc.
+			inFlight, ok := p.htlcs[event.circuitKey]
+			switch {
+			// If lnd requires the interceptor and hadn't forwarded the htlc
+			// on startup, it was held while we were disconnected. Remove it
+			// from the pending set and decide on it like any other htlc.
+			case ok && p.interceptorRequired && inFlight.held:
+				delete(p.htlcs, event.circuitKey)
+				newHtlcAllowed = p.newHtlcAllowed()
+
+				logger.Infow("Recovered held htlc")
+
+			// Otherwise just resume.
+			case ok:
 				if err := event.resume(true); err != nil {
 					return err
 				}
@@ -296,6 +448,7 @@ func (p *peerController) run(ctx context.Context) error {
 
 			mode := p.cfg.Mode
 
+			var reason rejectReason
 			switch {
 			// Don't check limits in block mode and move onwards to failing the
 			// htlc.
@@ -304,14 +457,17 @@ func (p *peerController) run(ctx context.Context) error {
 
 			// If there is a queue, then don't jump the queue.
 			case queue.Len() > 0:
+				reason = reasonQueueNotEmpty
 
 			// Check if new htlcs are allowed.
 			case !newHtlcAllowed:
 				logger.Infow("Pending htlc limit exceeded")
+				reason = reasonPendingLimit
 
 			// Check the rate limit.
 			case !p.limiter.Allow():
 				logger.Infow("Rate limit exceeded")
+				reason = reasonRateLimit
 
 			// All signs green, forward the htlc.
 			default:
@@ -319,6 +475,8 @@ func (p *peerController) run(ctx context.Context) error {
 					return err
 				}
 
+				p.decide(event.interceptEvent, decisionForward, reasonNone)
+
 				continue
 			}
 
@@ -326,10 +484,18 @@ func (p *peerController) run(ctx context.Context) error {
 			if mode == ModeQueue ||
 				(mode == ModeQueuePeerInitiated && event.peerInitiated) {
 
+				event.queuedAt = p.now()
 				queue.PushFront(event)
 
 				logger.Infow("Queued", "queueLen", queue.Len())
 
+				decision := p.newDecision(
+					event.interceptEvent, decisionQueue,
+				)
+				decision.reason = reason
+				decision.queuePosition = queue.Len()
+				p.decided(decision)
+
 				continue
 			}
 
@@ -340,6 +506,12 @@ func (p *peerController) run(ctx context.Context) error {
 
 			p.incrCounter(eventReject)
 
+			if mode == ModeBlock {
+				p.decide(event.interceptEvent, decisionBlock, reasonNone)
+			} else {
+				p.decide(event.interceptEvent, decisionReject, reason)
+			}
+
 		// There are items in the queue, max pending htlcs has not yet been
 		// reached, and the rate limit delay has passed. Take the oldest item
 		// from the queue and forward it.
@@ -356,6 +528,11 @@ func (p *peerController) run(ctx context.Context) error {
 				return err
 			}
 
+			decision := p.newDecision(event.interceptEvent, decisionForward)
+			decision.dequeued = true
+			decision.queueWait = decision.time.Sub(event.queuedAt)
+			p.decided(decision)
+
 			// Reservation has been used. Clear it so that a new reservation can
 			// be requested.
 			reservation = nil
@@ -373,8 +550,29 @@ func (p *peerController) run(ctx context.Context) error {
 				continue
 			}
 
+			inFlight := p.htlcs[key]
 			p.markHtlcComplete(ctx, key, &resolvedEvent)
 
+			// The add time is unknown for htlcs that were already
+			// pending on startup.
+			var holdTime time.Duration
+			if !inFlight.addedTs.IsZero() {
+				holdTime = resolvedEvent.timestamp.Sub(inFlight.addedTs)
+			}
+
+			p.decided(&htlcDecision{
+				time:            resolvedEvent.timestamp,
+				decision:        decisionResolve,
+				incomingPeer:    p.pubKey,
+				incomingCircuit: key,
+				outgoingPeer:    resolvedEvent.outgoingPeer,
+				outgoingCircuit: resolvedEvent.outgoingCircuitKey,
+				incomingMsat:    inFlight.incomingMsat,
+				outgoingMsat:    inFlight.outgoingMsat,
+				settled:         resolvedEvent.settled,
+				holdTime:        holdTime,
+			})
+
 			// Update rate counters.
 			if resolvedEvent.settled {
 				p.incrCounter(eventSuccess)
@@ -392,15 +590,43 @@ func (p *peerController) run(ctx context.Context) error {
 
 			p.limiter.SetLimit(getRate(limit.MaxHourlyRate))
 
-		case respChan := <-p.getStateChan:
-			counts := p.rateInternal()
+		// An operator resolves queued htlcs. Errors that are caused by
+		// the request are only passed on to the requester.
+		case req := <-p.queueActionChan:
+			htlcs, err := p.resolveQueued(queue, req)
+			req.resp <- &queueActionResult{
+				peer:  p.pubKey,
+				htlcs: htlcs,
+				err:   err,
+			}
 
-			select {
-			case respChan <- &peerState{
-				counts:           counts,
+			if err != nil && !errors.Is(err, errQueuedHtlcNotFound) {
+				return err
+			}
+
+			// A reservation is only made while there are htlcs to
+			// forward. Give back the token if the queue is now empty.
+			if queue.Len() == 0 && reservation != nil {
+				reservation.Cancel()
+				reservation = nil
+			}
+
+		case req := <-p.getStateChan:
+			state := &peerState{
+				counts:           p.rateInternal(),
 				queueLen:         int64(queue.Len()),
 				pendingHtlcCount: int64(len(p.htlcs)),
-			}:
+				limit:            p.cfg,
+				tokens:           p.limiter.Tokens(),
+			}
+
+			if req.detailed {
+				state.pendingHtlcs = p.pendingSnapshot()
+				state.queuedHtlcs = queueSnapshot(queue)
+			}
+
+			select {
+			case req.resp <- state:
 
 			case <-ctx.Done():
 				return ctx.Err()
@@ -412,6 +638,130 @@ func (p *peerController) run(ctx context.Context) error {
 	}
 }
 
+// pendingSnapshot returns the htlcs that are currently pending, ordered by the
+// time that they were forwarded.
+func (p *peerController) pendingSnapshot() []*htlcSnapshot {
+	htlcs := make([]*htlcSnapshot, 0, len(p.htlcs))
+	for key, htlc := range p.htlcs {
+		htlcs = append(htlcs, &htlcSnapshot{
+			circuitKey:   key,
+			since:        htlc.addedTs,
+			incomingMsat: htlc.incomingMsat,
+			outgoingMsat: htlc.outgoingMsat,
+		})
+	}
+
+	sort.Slice(htlcs, func(i, j int) bool {
+		if !htlcs[i].since.Equal(htlcs[j].since) {
+			return htlcs[i].since.Before(htlcs[j].since)
+		}
+
+		if htlcs[i].channel != htlcs[j].channel {
+			return htlcs[i].channel < htlcs[j].channel
+		}
+
+		return htlcs[i].htlc < htlcs[j].htlc
+	})
+
+	return htlcs
+}
+
+// queueSnapshot returns the htlcs in the queue provided, starting with the
+// oldest one at the back of the queue that is forwarded next.
+func queueSnapshot(queue *list.List) []*htlcSnapshot {
+	htlcs := make([]*htlcSnapshot, 0, queue.Len())
+	for item := queue.Back(); item != nil; item = item.Prev() {
+		htlcs = append(htlcs, queuedSnapshot(
+			item.Value.(peerInterceptEvent),
+		))
+	}
+
+	return htlcs
+}
+
+func queuedSnapshot(event peerInterceptEvent) *htlcSnapshot {
+	return &htlcSnapshot{
+		circuitKey:      event.circuitKey,
+		since:           event.queuedAt,
+		incomingMsat:    event.incomingMsat,
+		outgoingMsat:    event.outgoingMsat,
+		outgoingChannel: event.outgoingChannel,
+	}
+}
+
+// resolveQueued applies an operator action to the queued htlcs selected by the
+// request. Forwards by an operator are not subject to the pending and rate
+// limits. The resolved htlcs are returned, oldest first.
+func (p *peerController) resolveQueued(queue *list.List,
+	req *queueActionRequest) ([]*htlcSnapshot, error) {
+
+	if req.action != queueActionForward && req.action != queueActionFail {
+		return nil, fmt.Errorf("unknown queue action %v", req.action)
+	}
+
+	var items []*list.Element
+	for item := queue.Back(); item != nil; item = item.Prev() {
+		event := item.Value.(peerInterceptEvent)
+		if req.key == nil || event.circuitKey == *req.key {
+			items = append(items, item)
+		}
+	}
+
+	if req.key != nil && len(items) == 0 {
+		return nil, errQueuedHtlcNotFound
+	}
+
+	htlcs := make([]*htlcSnapshot, 0, len(items))
+	for _, item := range items {
+		queue.Remove(item)
+		event := item.Value.(peerInterceptEvent)
+
+		var decision *htlcDecision
+		if req.action == queueActionForward {
+			if err := p.forward(event.interceptEvent); err != nil {
+				return htlcs, err
+			}
+
+			decision = p.newDecision(event.interceptEvent, decisionForward)
+			decision.dequeued = true
+		} else {
+			if err := event.resume(false); err != nil {
+				return htlcs, err
+			}
+
+			p.incrCounter(eventReject)
+
+			decision = p.newDecision(event.interceptEvent, decisionReject)
+			decision.reason = reasonOperator
+		}
+
+		decision.queueWait = decision.time.Sub(event.queuedAt)
+		decision.operator = true
+		p.decided(decision)
+
+		logger := p.keyLogger(event.circuitKey)
+		logger.Infow("Queued htlc resolved by operator",
+			"action", req.action, "queueLen", queue.Len())
+
+		htlcs = append(htlcs, queuedSnapshot(event))
+	}
+
+	return htlcs, nil
+}
+
+// applyQueueAction passes an operator action on to the controller loop.
+func (p *peerController) applyQueueAction(ctx context.Context,
+	req *queueActionRequest) error {
+
+	select {
+	case p.queueActionChan <- req:
+		return nil
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // markHtlcComplete removes the resolved htlc provided from the peerController's
 // inFlight set and reports the completed htlc.
 func (p *peerController) markHtlcComplete(ctx context.Context, key circuitKey,
@@ -442,6 +792,7 @@ func (p *peerController) markHtlcComplete(ctx context.Context, key circuitKey,
 
 	// Track available HTLC information and report to handler.
 	htlcInfo := &HtlcInfo{
+		source:          sourceLive,
 		addTime:         inFlight.addedTs,
 		resolveTime:     resolution.timestamp,
 		settled:         resolution.settled,
@@ -490,6 +841,31 @@ func (p *peerController) forward(event interceptEvent) error {
 	return nil
 }
 
+// newDecision returns a decision on the intercepted htlc provided.
+func (p *peerController) newDecision(event interceptEvent,
+	decision decisionType) *htlcDecision {
+
+	return &htlcDecision{
+		time:            p.now(),
+		decision:        decision,
+		incomingPeer:    p.pubKey,
+		incomingCircuit: event.circuitKey,
+		outgoingCircuit: circuitKey{channel: event.outgoingChannel},
+		incomingMsat:    event.incomingMsat,
+		outgoingMsat:    event.outgoingMsat,
+	}
+}
+
+// decide reports a decision on the intercepted htlc provided.
+func (p *peerController) decide(event interceptEvent, decision decisionType,
+	reason rejectReason) {
+
+	htlcDecision := p.newDecision(event, decision)
+	htlcDecision.reason = reason
+
+	p.decided(htlcDecision)
+}
+
 func (p *peerController) process(ctx context.Context,
 	event peerInterceptEvent) error {
 
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"math"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// defaultPeerStatsWindows are the windows over which peer statistics are
+// computed if none are requested.
+var defaultPeerStatsWindows = []time.Duration{
+	time.Hour, 24 * time.Hour, 7 * 24 * time.Hour,
+}
+
+const (
+	// maxPeerStatsWindows is the maximum number of windows that can be
+	// requested at once.
+	maxPeerStatsWindows = 10
+
+	// maxPeerStatsWindow is the largest window that can be requested.
+	// Statistics over longer periods are available from the rollups.
+	maxPeerStatsWindow = 30 * 24 * time.Hour
+)
+
+// directionStats are the statistics of the htlcs that a peer forwarded to us,
+// or that we forwarded to the peer.
+type directionStats struct {
+	settled, failed int
+
+	// fees is the fee earned with the settled htlcs.
+	fees lnwire.MilliSatoshi
+
+	// holdTimeP50, holdTimeP90 and holdTimeP99 are percentiles of the hold
+	// times of the htlcs of which the add time is known.
+	holdTimeP50, holdTimeP90, holdTimeP99 time.Duration
+}
+
+// settleRatio returns the share of htlcs that settled, or zero if there are
+// no htlcs.
+func (d *directionStats) settleRatio() float64 {
+	total := d.settled + d.failed
+	if total == 0 {
+		return 0
+	}
+
+	return float64(d.settled) / float64(total)
+}
+
+// percentileRank returns the one-based nearest-rank of a percentile in a
+// sorted list of n values.
+func percentileRank(percentile float64, n int) int {
+	rank := int(math.Ceil(percentile / 100 * float64(n)))
+	if rank < 1 {
+		rank = 1
+	}
+
+	return rank
+}
+
+// peerWindowStats are the statistics of a peer over a window that ends now.
+type peerWindowStats struct {
+	window   time.Duration
+	incoming directionStats
+	outgoing directionStats
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPeerStats(t *testing.T) {
+	ctx := context.Background()
+
+	db, cleanup := setupTestDb(t, defaultFwdHistoryLimit)
+	defer cleanup()
+
+	now := time.Unix(100000, 0)
+	peer, otherPeer := route.Vertex{1}, route.Vertex{2}
+
+	var index uint64
+	htlc := func(incoming, outgoing route.Vertex, age,
+		holdTime time.Duration, settled bool) *HtlcInfo {
+
+		index++
+		addTime := now.Add(-age)
+
+		return &HtlcInfo{
+			source:       sourceLive,
+			addTime:      addTime,
+			resolveTime:  addTime.Add(holdTime),
+			settled:      settled,
+			incomingMsat: 1100,
+			outgoingMsat: 1000,
+			incomingPeer: incoming,
+			outgoingPeer: outgoing,
+			incomingCircuit: circuitKey{
+				channel: 1,
+				htlc:    index,
+			},
+			outgoingCircuit: circuitKey{
+				channel: 2,
+				htlc:    index,
+			},
+		}
+	}
+
+	// Imported htlcs count towards the fees, but have no hold time. They
+	// are imported before live forwards are recorded.
+	imported := htlc(otherPeer, peer, time.Minute, 0, true)
+	imported.source = sourceLnd
+	count, err := db.ImportForwardingHistory(
+		ctx, testNodeKey, []*HtlcInfo{imported},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	for _, htlc := range []*HtlcInfo{
+		htlc(peer, otherPeer, time.Minute, time.Second, true),
+		htlc(peer, otherPeer, 2*time.Minute, 2*time.Second, true),
+		htlc(peer, otherPeer, 3*time.Minute, 3*time.Second, false),
+		htlc(peer, otherPeer, 4*time.Minute, 10*time.Second, true),
+		htlc(peer, otherPeer, 2*time.Hour, time.Minute, true),
+		htlc(otherPeer, peer, time.Minute, time.Second, false),
+	} {
+		require.NoError(t, db.RecordHtlcResolution(ctx, testNodeKey, htlc))
+	}
+
+	stats, err := db.GetPeerStats(
+		ctx, testNodeKey, peer,
+		[]time.Duration{time.Hour, 24 * time.Hour}, now,
+	)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+
+	hour := stats[0]
+	require.Equal(t, time.Hour, hour.window)
+	require.Equal(t, 3, hour.incoming.settled)
+	require.Equal(t, 1, hour.incoming.failed)
+	require.Equal(t, 0.75, hour.incoming.settleRatio())
+	require.EqualValues(t, 300, hour.incoming.fees)
+	require.Equal(t, 2*time.Second, hour.incoming.holdTimeP50)
+	require.Equal(t, 10*time.Second, hour.incoming.holdTimeP90)
+	require.Equal(t, 10*time.Second, hour.incoming.holdTimeP99)
+
+	require.Equal(t, 1, hour.outgoing.settled)
+	require.Equal(t, 1, hour.outgoing.failed)
+	require.EqualValues(t, 100, hour.outgoing.fees)
+	require.Equal(t, time.Second, hour.outgoing.holdTimeP50)
+
+	day := stats[1]
+	require.Equal(t, 4, day.incoming.settled)
+	require.EqualValues(t, 400, day.incoming.fees)
+	require.Equal(t, time.Minute, day.incoming.holdTimeP99)
+
+	// Windows without htlcs have zero statistics.
+	stats, err = db.GetPeerStats(
+		ctx, testNodeKey, route.Vertex{3}, []time.Duration{time.Hour},
+		now,
+	)
+	require.NoError(t, err)
+	require.Zero(t, stats[0].incoming.settleRatio())
+	require.Zero(t, stats[0].incoming.holdTimeP50)
+}
+
+func TestPercentileRank(t *testing.T) {
+	require.Equal(t, 1, percentileRank(50, 1))
+	require.Equal(t, 2, percentileRank(50, 4))
+	require.Equal(t, 4, percentileRank(90, 4))
+	require.Equal(t, 99, percentileRank(99, 100))
+	require.Equal(t, 1, percentileRank(0, 10))
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -30,6 +33,9 @@ type lndclient interface {
 
 	getNodeAlias(key route.Vertex) (string, error)
 
+	forwardingHistory(start, end time.Time, offset, maxEvents uint32) (
+		[]*forwardingEvent, uint32, error)
+
 	subscribeHtlcEvents(ctx context.Context) (htlcEventsClient, error)
 
 	htlcInterceptor(ctx context.Context) (htlcInterceptorClient, error)
@@ -45,9 +51,10 @@ type circuitKey struct {
 
 type interceptEvent struct {
 	circuitKey
-	incomingMsat lnwire.MilliSatoshi
-	outgoingMsat lnwire.MilliSatoshi
-	resume       func(bool) error
+	incomingMsat    lnwire.MilliSatoshi
+	outgoingMsat    lnwire.MilliSatoshi
+	outgoingChannel uint64
+	resume          func(bool) error
 }
 
 type resolvedEvent struct {
@@ -63,10 +70,20 @@ type rateCounters struct {
 
 type rateCountersRequest struct {
 	counters chan *rateCounters
+
+	// detailed requests the htlcs that are pending and queued too.
+	detailed bool
+}
+
+// peerStateRequest requests the detailed state of a single peer. A nil state
+// is returned if there is no controller for the peer.
+type peerStateRequest struct {
+	peer  route.Vertex
+	state chan *peerState
 }
 
 type process struct {
-	db     *Db
+	db     Store
 	client lndclient
 	limits *Limits
 	log    *zap.SugaredLogger
@@ -75,22 +92,78 @@ type process struct {
 	resolveChan             chan resolvedEvent
 	updateLimitChan         chan updateLimitEvent
 	rateCountersRequestChan chan rateCountersRequest
+	peerStateRequestChan    chan peerStateRequest
+	queueActionChan         chan *queueActionRequest
 	newPeerChan             chan route.Vertex
 
+	// pingChan is used by the health checks to verify that the event loop
+	// is responsive.
+	pingChan chan struct{}
+
+	// stopInterceptsChan makes the event loop stop accepting intercepted
+	// htlcs on shutdown.
+	stopInterceptsChan chan struct{}
+
 	identity route.Vertex
 	chanMap  map[uint64]*channel
 	aliasMap map[route.Vertex]string
 
 	peerCtrls map[route.Vertex]*peerController
 
+	// restoredCounts are the rate counts that were saved on the last
+	// shutdown, for peers that don't have a controller yet. They are only
+	// accessed by the event loop.
+	restoredCounts map[route.Vertex][]rateCounts
+
+	// decisions fans out the decisions of all peer controllers.
+	decisions *decisionBroker
+
+	// metrics is updated with the decisions of all peer controllers if
+	// set.
+	metrics *metrics
+
+	// notifier is informed about decisions and errors if set.
+	notifier *notifier
+
 	burstSize           int
 	peerRefreshInterval time.Duration
 
+	// allowUnsafeQueue allows queue modes to be configured even if the
+	// connected lnd version doesn't auto-fail held htlcs.
+	allowUnsafeQueue bool
+
+	// requireInterceptor makes the process refuse to run if lnd isn't
+	// configured to hold htlcs while no interceptor is connected.
+	requireInterceptor bool
+
+	// compat is the compatibility of the connected lnd version. It is nil
+	// until the process has connected to lnd.
+	compat *lndCompatibility
+
+	// interceptorRequired indicates whether lnd holds htlcs while no
+	// interceptor is connected, instead of forwarding them unchecked.
+	interceptorRequired bool
+
+	// connected, htlcEventsActive and interceptorActive report the state of
+	// the connection to lnd to the health checks.
+	connected         bool
+	htlcEventsActive  bool
+	interceptorActive bool
+
+	// shuttingDown indicates that an orderly shutdown has started.
+	shuttingDown bool
+
+	// lastLndEvent is the time at which the last htlc event or intercepted
+	// htlc was received from lnd.
+	lastLndEvent time.Time
+
+	lndStateLock sync.Mutex
+
 	// Testing hook
 	resolvedCallback func()
 }
 
-func NewProcess(client lndclient, log *zap.SugaredLogger, limits *Limits, db *Db) *process {
+func NewProcess(client lndclient, log *zap.SugaredLogger, limits *Limits, db Store) *process {
 	return &process{
 		db:                      db,
 		log:                     log,
@@ -99,35 +172,43 @@ func NewProcess(client lndclient, log *zap.SugaredLogger, limits *Limits, db *Db
 		resolveChan:             make(chan resolvedEvent),
 		updateLimitChan:         make(chan updateLimitEvent),
 		rateCountersRequestChan: make(chan rateCountersRequest),
+		peerStateRequestChan:    make(chan peerStateRequest),
+		queueActionChan:         make(chan *queueActionRequest),
 		newPeerChan:             make(chan route.Vertex),
+		pingChan:                make(chan struct{}),
+		stopInterceptsChan:      make(chan struct{}),
 		chanMap:                 make(map[uint64]*channel),
 		aliasMap:                make(map[route.Vertex]string),
 		peerCtrls:               make(map[route.Vertex]*peerController),
+		restoredCounts:          make(map[route.Vertex][]rateCounts),
+		decisions:               newDecisionBroker(),
 		limits:                  limits,
 		burstSize:               burstSize,
 		peerRefreshInterval:     defaultPeerRefreshInterval,
 	}
 }
 
+// updateLimitEvent is a batch of limit updates that is applied at once.
 type updateLimitEvent struct {
-	limit *Limit
-	peer  *route.Vertex
+	updates []LimitUpdate
 }
 
-func (p *process) UpdateLimit(ctx context.Context, peer *route.Vertex,
-	limit *Limit) error {
+// UpdateLimits applies a batch of limit updates to the process.
+func (p *process) UpdateLimits(ctx context.Context,
+	updates []LimitUpdate) error {
 
-	if peer == nil && limit == nil {
-		return errors.New("cannot clear default limit")
+	for _, update := range updates {
+		if update.Peer == defaultNodeKey && update.Limit == nil {
+			return errors.New("cannot clear default limit")
+		}
 	}
 
-	update := updateLimitEvent{
-		limit: limit,
-		peer:  peer,
+	event := updateLimitEvent{
+		updates: updates,
 	}
 
 	select {
-	case p.updateLimitChan <- update:
+	case p.updateLimitChan <- event:
 		return nil
 
 	case <-ctx.Done():
@@ -135,6 +216,62 @@ func (p *process) UpdateLimit(ctx context.Context, peer *route.Vertex,
 	}
 }
 
+// getCompatibility returns the compatibility of the connected lnd version, or
+// nil if the process hasn't connected to lnd yet.
+func (p *process) getCompatibility() *lndCompatibility {
+	p.lndStateLock.Lock()
+	defer p.lndStateLock.Unlock()
+
+	return p.compat
+}
+
+// isInterceptorRequired returns whether lnd is configured to hold htlcs while
+// no interceptor is connected.
+func (p *process) isInterceptorRequired() bool {
+	p.lndStateLock.Lock()
+	defer p.lndStateLock.Unlock()
+
+	return p.interceptorRequired
+}
+
+// checkLimit verifies that the limit can safely be applied with the connected
+// lnd version.
+func (p *process) checkLimit(limit Limit) error {
+	err := checkLimitCompat(limit, p.getCompatibility(), p.allowUnsafeQueue)
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return nil
+}
+
+// checkLimitCompat verifies that the limit can safely be applied with the lnd
+// version provided. A nil compatibility means that the version isn't known
+// yet.
+func checkLimitCompat(limit Limit, compat *lndCompatibility,
+	allowUnsafeQueue bool) error {
+
+	if limit.Mode != ModeQueue && limit.Mode != ModeQueuePeerInitiated {
+		return nil
+	}
+
+	if allowUnsafeQueue {
+		return nil
+	}
+
+	switch {
+	case compat == nil:
+		return fmt.Errorf("%w: not connected to lnd yet",
+			errUnsafeQueueMode)
+
+	case !compat.supports(featureHtlcAutoFail):
+		return fmt.Errorf("%w: %v does not auto-fail held htlcs",
+			errUnsafeQueueMode, compat.version)
+	}
+
+	return nil
+}
+
 func (p *process) Run(ctx context.Context) error {
 	p.log.Info("CircuitBreaker started")
 
@@ -145,7 +282,47 @@ func (p *process) Run(ctx context.Context) error {
 	p.identity = info.nodeKey
 
 	p.log.Infow("Connected to lnd node",
-		"pubkey", p.identity.String())
+		"pubkey", p.identity.String(),
+		"version", info.version)
+
+	compat, err := newLndCompatibility(info.version)
+	if err != nil {
+		p.log.Warnw("Unable to parse lnd version, assuming no optional "+
+			"features are supported", "err", err)
+	}
+
+	p.lndStateLock.Lock()
+	p.compat = compat
+	p.interceptorRequired = info.requireInterceptor
+	p.connected = true
+	p.lndStateLock.Unlock()
+
+	defer func() {
+		p.lndStateLock.Lock()
+		p.connected = false
+		p.lndStateLock.Unlock()
+	}()
+
+	if !compat.supports(featureHtlcAutoFail) {
+		p.log.Warnw("Lnd version does not auto-fail held htlcs, queue "+
+			"modes risk force-closes", "version", info.version,
+			"allowUnsafeQueue", p.allowUnsafeQueue)
+	}
+
+	// Without a required interceptor, lnd forwards all htlcs unchecked while
+	// circuitbreaker isn't connected.
+	if info.requireInterceptor {
+		p.log.Infow("Lnd requires the htlc interceptor, htlcs held " +
+			"while disconnected will be recovered")
+	} else {
+		if p.requireInterceptor {
+			return errors.New("lnd is not configured with " +
+				"requireinterceptor=true")
+		}
+
+		p.log.Warnw("Lnd does not require the htlc interceptor, limits " +
+			"are not enforced while circuitbreaker is not running")
+	}
 
 	group, ctx := errgroup.WithContext(ctx)
 
@@ -161,19 +338,42 @@ func (p *process) Run(ctx context.Context) error {
 
 	p.log.Info("Interceptor/notification handlers registered")
 
+	p.lndStateLock.Lock()
+	p.htlcEventsActive = true
+	p.interceptorActive = true
+	p.lndStateLock.Unlock()
+
 	group.Go(func() error {
+		defer func() {
+			p.lndStateLock.Lock()
+			p.htlcEventsActive = false
+			p.lndStateLock.Unlock()
+		}()
+
 		err := p.processHtlcEvents(ctx, stream)
 		if err != nil {
-			return fmt.Errorf("htlc events error: %w", err)
+			err = fmt.Errorf("htlc events error: %w", err)
+			p.streamFailed(ctx, err)
+
+			return err
 		}
 
 		return nil
 	})
 
 	group.Go(func() error {
+		defer func() {
+			p.lndStateLock.Lock()
+			p.interceptorActive = false
+			p.lndStateLock.Unlock()
+		}()
+
 		err := p.processInterceptor(ctx, interceptor)
 		if err != nil {
-			return fmt.Errorf("interceptor error: %w", err)
+			err = fmt.Errorf("interceptor error: %w", err)
+			p.streamFailed(ctx, err)
+
+			return err
 		}
 
 		return err
@@ -247,14 +447,22 @@ func (p *process) createPeerController(ctx context.Context, peer route.Vertex,
 		peerCfg = p.limits.Default
 	}
 
+	// Counts that were restored on startup are only applied once.
+	counts := p.restoredCounts[peer]
+	delete(p.restoredCounts, peer)
+
 	cfg := &peerControllerCfg{
 		logger:    p.log,
 		limit:     peerCfg,
 		burstSize: p.burstSize,
 		htlcs:     htlcs,
+		counts:    counts,
 		lnd:       p.client,
 		pubKey:    peer,
 		now:       time.Now,
+
+		interceptorRequired: p.interceptorRequired,
+		decided:             p.decided,
 		htlcCompleted: func(ctx context.Context, htlc *HtlcInfo) error {
 			// If the add time of a htlc is zero, it was resumed after a LND
 			// restart. We don't store these htlcs because they have
@@ -270,7 +478,14 @@ func (p *process) createPeerController(ctx context.Context, peer route.Vertex,
 				return nil
 			}
 
-			return p.db.RecordHtlcResolution(ctx, htlc)
+			err := p.db.RecordHtlcResolution(ctx, p.identity, htlc)
+			if err != nil && p.notifier != nil &&
+				!errors.Is(err, context.Canceled) {
+
+				p.notifier.dbError(p.identity, peer, err)
+			}
+
+			return err
 		},
 	}
 	ctrl := newPeerController(cfg)
@@ -284,6 +499,29 @@ func (p *process) createPeerController(ctx context.Context, peer route.Vertex,
 	return ctrl
 }
 
+// decided reports a decision of one of the peer controllers.
+func (p *process) decided(decision *htlcDecision) {
+	p.decisions.publish(decision)
+
+	if p.metrics != nil {
+		p.metrics.observe(p.identity, decision)
+	}
+
+	if p.notifier != nil {
+		p.notifier.observe(p.identity, decision)
+	}
+}
+
+// streamFailed reports the failure of an lnd stream. Failures that are caused
+// by shutting down are not reported.
+func (p *process) streamFailed(ctx context.Context, err error) {
+	if p.notifier == nil || ctx.Err() != nil {
+		return
+	}
+
+	p.notifier.lndStreamError(p.identity, err)
+}
+
 func (p *process) runEventLoop(ctx context.Context) error {
 	group, ctx := errgroup.WithContext(ctx)
 
@@ -304,14 +542,33 @@ func (p *process) eventLoop(ctx context.Context, group *errgroup.Group) error {
 		return err
 	}
 
+	// Restore the add times and amounts of pending htlcs and the rate
+	// counters that were saved on shutdown.
+	if err := p.restoreState(ctx, htlcsPerPeer); err != nil {
+		return err
+	}
+
 	// Initialize peer controllers with currently pending htlcs.
 	for peer, htlcs := range htlcsPerPeer {
 		p.createPeerController(ctx, peer, group.Go, htlcs)
 	}
 
+	acceptIntercepts := true
+
 	for {
 		select {
 		case interceptEvent := <-p.interceptChan:
+			// During shutdown, intercepted htlcs are left to lnd. They
+			// are held until circuitbreaker reconnects if lnd requires
+			// the interceptor, and forwarded otherwise.
+			if !acceptIntercepts {
+				p.log.Infow("Not accepting htlc during shutdown",
+					"channel", interceptEvent.channel,
+					"htlc", interceptEvent.htlc)
+
+				continue
+			}
+
 			chanInfo, err := p.getChanInfo(interceptEvent.channel)
 			if err != nil {
 				return err
@@ -374,56 +631,16 @@ func (p *process) eventLoop(ctx context.Context, group *errgroup.Group) error {
 				p.resolvedCallback()
 			}
 
-		case update := <-p.updateLimitChan:
-			switch {
-			// Update sets default limit.
-			case update.peer == nil:
-				p.limits.Default = *update.limit
-
-				// Update all controllers that have no specific limit.
-				for node, ctrl := range p.peerCtrls {
-					_, ok := p.limits.PerPeer[node]
-					if ok {
-						continue
-					}
-
-					err := ctrl.updateLimit(ctx, *update.limit)
-					if err != nil {
-						return err
-					}
-				}
-
-			// Update sets specific limit.
-			case update.limit != nil:
-				p.limits.PerPeer[*update.peer] = *update.limit
-
-				// Update specific controller if it exists.
-				ctrl, ok := p.peerCtrls[*update.peer]
-				if ok {
-					err := ctrl.updateLimit(ctx, *update.limit)
-					if err != nil {
-						return err
-					}
-				}
-
-			// Update clears limit.
-			case update.limit == nil:
-				delete(p.limits.PerPeer, *update.peer)
-
-				// Apply default limit to peer controller.
-				ctrl, ok := p.peerCtrls[*update.peer]
-				if ok {
-					err := ctrl.updateLimit(ctx, p.limits.Default)
-					if err != nil {
-						return err
-					}
-				}
+		case event := <-p.updateLimitChan:
+			err := p.applyLimitUpdates(ctx, event.updates)
+			if err != nil {
+				return err
 			}
 
 		case req := <-p.rateCountersRequestChan:
 			allCounts := make(map[route.Vertex]*peerState)
 			for node, ctrl := range p.peerCtrls {
-				state, err := ctrl.state(ctx)
+				state, err := ctrl.state(ctx, req.detailed)
 				if err != nil {
 					return err
 				}
@@ -435,6 +652,54 @@ func (p *process) eventLoop(ctx context.Context, group *errgroup.Group) error {
 				counters: allCounts,
 			}
 
+		case req := <-p.peerStateRequestChan:
+			var state *peerState
+			if ctrl, ok := p.peerCtrls[req.peer]; ok {
+				state, err = ctrl.state(ctx, true)
+				if err != nil {
+					return err
+				}
+			}
+
+			req.state <- state
+
+		// The controller replies to the requester directly, so that
+		// the event loop doesn't wait for the htlcs to be resolved.
+		case req := <-p.queueActionChan:
+			peer := req.peer
+			if req.key != nil {
+				chanInfo, err := p.getChanInfo(req.key.channel)
+				if err != nil {
+					req.resp <- &queueActionResult{err: err}
+
+					continue
+				}
+
+				peer = chanInfo.peer
+			}
+
+			ctrl, ok := p.peerCtrls[peer]
+			if !ok {
+				result := &queueActionResult{peer: peer}
+				if req.key != nil {
+					result.err = errQueuedHtlcNotFound
+				}
+				req.resp <- result
+
+				continue
+			}
+
+			if err := ctrl.applyQueueAction(ctx, req); err != nil {
+				return err
+			}
+
+		// The health checks only need the event loop to pick up the
+		// ping.
+		case <-p.pingChan:
+
+		case <-p.stopInterceptsChan:
+			acceptIntercepts = false
+
 		case <-ctx.Done():
 			return ctx.Err()
 
@@ -449,14 +714,70 @@ func (p *process) eventLoop(ctx context.Context, group *errgroup.Group) error {
 	}
 }
 
+// applyLimitUpdates updates the limits and then pushes the new limits to the
+// affected peer controllers.
+func (p *process) applyLimitUpdates(ctx context.Context,
+	updates []LimitUpdate) error {
+
+	var defaultUpdated bool
+	affected := make(map[route.Vertex]struct{})
+	for _, update := range updates {
+		switch {
+		// Update sets default limit.
+		case update.Peer == defaultNodeKey:
+			p.limits.Default = *update.Limit
+			defaultUpdated = true
+
+		// Update sets specific limit.
+		case update.Limit != nil:
+			p.limits.PerPeer[update.Peer] = *update.Limit
+			affected[update.Peer] = struct{}{}
+
+		// Update clears limit.
+		default:
+			delete(p.limits.PerPeer, update.Peer)
+			affected[update.Peer] = struct{}{}
+		}
+	}
+
+	for node, ctrl := range p.peerCtrls {
+		limit, ok := p.limits.PerPeer[node]
+		_, isAffected := affected[node]
+
+		switch {
+		// Apply default limit to controllers that have no specific limit.
+		case !ok && (defaultUpdated || isAffected):
+			limit = p.limits.Default
+
+		case !isAffected:
+			continue
+		}
+
+		if err := ctrl.updateLimit(ctx, limit); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (p *process) getRateCounters(ctx context.Context) (
 	map[route.Vertex]*peerState, error) {
 
+	return p.getPeerStates(ctx, false)
+}
+
+// getPeerStates returns the states of all peers. Detailed states include the
+// htlcs that are pending and queued.
+func (p *process) getPeerStates(ctx context.Context, detailed bool) (
+	map[route.Vertex]*peerState, error) {
+
 	replyChan := make(chan *rateCounters)
 
 	select {
 	case p.rateCountersRequestChan <- rateCountersRequest{
 		counters: replyChan,
+		detailed: detailed,
 	}:
 	case <-ctx.Done():
 		return nil, ctx.Err()
@@ -471,6 +792,97 @@ func (p *process) getRateCounters(ctx context.Context) (
 	}
 }
 
+// getPeerState returns the detailed state of the peer provided, or nil if the
+// peer is unknown.
+func (p *process) getPeerState(ctx context.Context, peer route.Vertex) (
+	*peerState, error) {
+
+	replyChan := make(chan *peerState, 1)
+
+	select {
+	case p.peerStateRequestChan <- peerStateRequest{
+		peer:  peer,
+		state: replyChan,
+	}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	select {
+	case state := <-replyChan:
+		return state, nil
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// resolveQueued applies an operator action to queued htlcs and returns the
+// htlcs that were resolved.
+func (p *process) resolveQueued(ctx context.Context,
+	req *queueActionRequest) (*queueActionResult, error) {
+
+	req.resp = make(chan *queueActionResult, 1)
+
+	select {
+	case p.queueActionChan <- req:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	select {
+	case result := <-req.resp:
+		return result, nil
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// lndHealth is the state of the connection to lnd as seen by the process.
+type lndHealth struct {
+	connected         bool
+	htlcEventsActive  bool
+	interceptorActive bool
+	shuttingDown      bool
+
+	// lastEvent is the time at which the last event was received from lnd.
+	// It is zero if no event has been received yet.
+	lastEvent time.Time
+}
+
+func (p *process) getLndHealth() lndHealth {
+	p.lndStateLock.Lock()
+	defer p.lndStateLock.Unlock()
+
+	return lndHealth{
+		connected:         p.connected,
+		htlcEventsActive:  p.htlcEventsActive,
+		interceptorActive: p.interceptorActive,
+		shuttingDown:      p.shuttingDown,
+		lastEvent:         p.lastLndEvent,
+	}
+}
+
+func (p *process) lndEventReceived() {
+	p.lndStateLock.Lock()
+	defer p.lndStateLock.Unlock()
+
+	p.lastLndEvent = time.Now()
+}
+
+// pingEventLoop returns once the event loop has picked up a ping, which shows
+// that it is still processing events.
+func (p *process) pingEventLoop(ctx context.Context) error {
+	select {
+	case p.pingChan <- struct{}{}:
+		return nil
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (p *process) processHtlcEvents(ctx context.Context,
 	stream htlcEventsClient) error {
 
@@ -479,6 +891,7 @@ func (p *process) processHtlcEvents(ctx context.Context,
 		if err != nil {
 			return err
 		}
+		p.lndEventReceived()
 
 		select {
 		case p.resolveChan <- *event:
@@ -496,6 +909,7 @@ func (p *process) processInterceptor(ctx context.Context,
 		if err != nil {
 			return err
 		}
+		p.lndEventReceived()
 
 		key := event.circuitKey
 
@@ -508,10 +922,11 @@ func (p *process) processInterceptor(ctx context.Context,
 
 		select {
 		case p.interceptChan <- interceptEvent{
-			circuitKey:   key,
-			incomingMsat: event.incomingMsat,
-			outgoingMsat: event.outgoingMsat,
-			resume:       resume,
+			circuitKey:      key,
+			incomingMsat:    event.incomingMsat,
+			outgoingMsat:    event.outgoingMsat,
+			outgoingChannel: event.outgoingChannel,
+			resume:          resume,
 		}:
 
 		case <-ctx.Done():
@@ -560,3 +560,71 @@ func TestClosedChannelHtlc(t *testing.T) {
 	cancel()
 	require.ErrorIs(t, <-exit, context.Canceled)
 }
+
+// TestRequiredInterceptor tests that pending htlcs that are intercepted again
+// are treated as replays, unless lnd requires the interceptor and hadn't
+// forwarded them on startup. In that case they were held by lnd and are
+// subjected to the limits.
+func TestRequiredInterceptor(t *testing.T) {
+	t.Run("replay", func(t *testing.T) {
+		testRequiredInterceptor(t, false, true)
+	})
+	t.Run("required", func(t *testing.T) {
+		testRequiredInterceptor(t, true, true)
+	})
+	t.Run("replay after restart", func(t *testing.T) {
+		testRequiredInterceptor(t, true, false)
+	})
+}
+
+func testRequiredInterceptor(t *testing.T, required, held bool) {
+	defer Timeout()()
+
+	db, cleanup := setupTestDb(t, defaultFwdHistoryLimit)
+	defer cleanup()
+
+	cfg := &Limits{
+		PerPeer: map[route.Vertex]Limit{
+			{2}: {
+				Mode: ModeBlock,
+			},
+		},
+	}
+
+	heldKey := circuitKey{
+		channel: 2,
+		htlc:    5,
+	}
+
+	client := newLndclientMock(testChannels, nil)
+	client.requireInterceptor = required
+	client.pendingHtlcs = map[route.Vertex]map[circuitKey]*inFlightHtlc{
+		{2}: {heldKey: {held: held}},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	log := zaptest.NewLogger(t).Sugar()
+
+	p := NewProcess(client, log, cfg, db)
+
+	exit := make(chan error)
+	go func() {
+		exit <- p.Run(ctx)
+	}()
+
+	// The pending htlc is offered again by lnd. A replay is resumed, but a
+	// held htlc is blocked like any other htlc from this peer.
+	client.htlcInterceptorRequests <- &interceptedEvent{
+		circuitKey: heldKey,
+	}
+	resp := <-client.htlcInterceptorResponses
+	require.Equal(t, heldKey, resp.key)
+	require.Equal(t, !(required && held), resp.resume)
+
+	require.Equal(t, required, p.isInterceptorRequired())
+
+	cancel()
+	require.ErrorIs(t, <-exit, context.Canceled)
+}
@@ -0,0 +1,287 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/lightningequipment/circuitbreaker/circuitbreakerrpc"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// queueActionTimeout is the time that an operator action on queued htlcs may
+// take, including recording the resolved htlcs.
+const queueActionTimeout = 10 * time.Second
+
+func unmarshalQueueAction(action circuitbreakerrpc.QueueAction) (queueAction,
+	error) {
+
+	switch action {
+	case circuitbreakerrpc.QueueAction_QUEUE_ACTION_FORWARD:
+		return queueActionForward, nil
+
+	case circuitbreakerrpc.QueueAction_QUEUE_ACTION_FAIL:
+		return queueActionFail, nil
+
+	default:
+		return 0, errors.New("queue action required")
+	}
+}
+
+func marshalQueueAction(action queueAction) circuitbreakerrpc.QueueAction {
+	switch action {
+	case queueActionForward:
+		return circuitbreakerrpc.QueueAction_QUEUE_ACTION_FORWARD
+
+	case queueActionFail:
+		return circuitbreakerrpc.QueueAction_QUEUE_ACTION_FAIL
+
+	default:
+		return circuitbreakerrpc.QueueAction_QUEUE_ACTION_UNKNOWN
+	}
+}
+
+func (s *server) ListQueuedHtlcs(ctx context.Context,
+	req *circuitbreakerrpc.ListQueuedHtlcsRequest) (
+	*circuitbreakerrpc.ListQueuedHtlcsResponse, error) {
+
+	lndNode, err := s.getNode(req.NodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	peer, err := parseOptionalPeer(req.Node)
+	if err != nil {
+		return nil, err
+	}
+
+	var states map[route.Vertex]*peerState
+	if peer != nil {
+		state, err := lndNode.process.getPeerState(ctx, *peer)
+		if err != nil {
+			return nil, err
+		}
+
+		states = map[route.Vertex]*peerState{}
+		if state != nil {
+			states[*peer] = state
+		}
+	} else {
+		states, err = lndNode.process.getPeerStates(ctx, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	queues := []*circuitbreakerrpc.PeerQueue{}
+	for node, state := range states {
+		if len(state.queuedHtlcs) == 0 {
+			continue
+		}
+
+		alias, err := s.getAlias(lndNode, node)
+		if err != nil {
+			return nil, err
+		}
+
+		queues = append(queues, &circuitbreakerrpc.PeerQueue{
+			Node:  node.String(),
+			Alias: alias,
+			Htlcs: marshalPeerHtlcs(state.queuedHtlcs, now),
+		})
+	}
+
+	// Sort by peer so that the listing is stable.
+	sort.Slice(queues, func(i, j int) bool {
+		return queues[i].Node < queues[j].Node
+	})
+
+	return &circuitbreakerrpc.ListQueuedHtlcsResponse{
+		Queues: queues,
+	}, nil
+}
+
+func (s *server) ResolveQueuedHtlc(ctx context.Context,
+	req *circuitbreakerrpc.ResolveQueuedHtlcRequest) (
+	*circuitbreakerrpc.ResolveQueuedHtlcResponse, error) {
+
+	lndNode, err := s.getNode(req.NodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	action, err := unmarshalQueueAction(req.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.IncomingCircuit == nil {
+		return nil, errors.New("incoming circuit required")
+	}
+
+	key := circuitKey{
+		channel: req.IncomingCircuit.ShortChannelId,
+		htlc:    uint64(req.IncomingCircuit.HtlcIndex),
+	}
+
+	_, err = s.resolveQueued(ctx, lndNode, &queueActionRequest{
+		action: action,
+		key:    &key,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &circuitbreakerrpc.ResolveQueuedHtlcResponse{}, nil
+}
+
+func (s *server) FlushQueue(ctx context.Context,
+	req *circuitbreakerrpc.FlushQueueRequest) (
+	*circuitbreakerrpc.FlushQueueResponse, error) {
+
+	lndNode, err := s.getNode(req.NodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	action, err := unmarshalQueueAction(req.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	peer, err := route.NewVertexFromStr(req.Node)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := s.resolveQueued(ctx, lndNode, &queueActionRequest{
+		action: action,
+		peer:   peer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &circuitbreakerrpc.FlushQueueResponse{
+		Resolved: uint64(resolved),
+	}, nil
+}
+
+// queueActionRecords returns the records of the htlcs that a queue action
+// resolved.
+func queueActionRecords(result *queueActionResult, action queueAction,
+	caller string, now time.Time) []*QueueActionRecord {
+
+	records := make([]*QueueActionRecord, len(result.htlcs))
+	for i, htlc := range result.htlcs {
+		records[i] = &QueueActionRecord{
+			Time:            now,
+			Peer:            result.peer,
+			Action:          action,
+			IncomingCircuit: htlc.circuitKey,
+			OutgoingChannel: htlc.outgoingChannel,
+			IncomingMsat:    htlc.incomingMsat,
+			OutgoingMsat:    htlc.outgoingMsat,
+			QueuedTime:      htlc.since,
+			Caller:          caller,
+		}
+	}
+
+	return records
+}
+
+// resolveQueued applies an operator action to queued htlcs and records the
+// htlcs that were resolved. The number of resolved htlcs is returned.
+func (s *server) resolveQueued(ctx context.Context, lndNode *lndNode,
+	req *queueActionRequest) (int, error) {
+
+	caller := callerIdentity(ctx)
+
+	// Htlcs that are resolved must be recorded, even if the caller goes
+	// away in the meantime. Detach from the cancellation of the request.
+	ctx, cancel := context.WithTimeout(
+		context.Background(), queueActionTimeout,
+	)
+	defer cancel()
+
+	result, err := lndNode.process.resolveQueued(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	records := queueActionRecords(result, req.action, caller, time.Now())
+
+	s.log.Infow("Resolved queued htlcs", "lndNode", lndNode.key,
+		"node", result.peer, "action", req.action,
+		"count", len(records), "caller", caller)
+
+	// Record the htlcs that were resolved, even if the action failed
+	// halfway.
+	if len(records) > 0 {
+		err := s.db.RecordQueueActions(ctx, lndNode.key, records)
+		if err != nil {
+			return 0, fmt.Errorf("resolved %v htlcs, but cannot "+
+				"record queue action: %w", len(records), err)
+		}
+	}
+
+	if result.err != nil {
+		return 0, result.err
+	}
+
+	return len(records), nil
+}
+
+func (s *server) ListQueueActions(ctx context.Context,
+	req *circuitbreakerrpc.ListQueueActionsRequest) (
+	*circuitbreakerrpc.ListQueueActionsResponse, error) {
+
+	lndNode, err := s.getNode(req.NodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &QueueActionsQuery{
+		Limit: int(req.Limit),
+	}
+
+	query.Peer, err = parseOptionalPeer(req.Node)
+	if err != nil {
+		return nil, err
+	}
+
+	actions, err := s.db.ListQueueActions(ctx, lndNode.key, query)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcActions := make([]*circuitbreakerrpc.QueueActionRecord, len(actions))
+	for i, action := range actions {
+		htlc := &htlcSnapshot{
+			circuitKey:      action.IncomingCircuit,
+			since:           action.QueuedTime,
+			incomingMsat:    action.IncomingMsat,
+			outgoingMsat:    action.OutgoingMsat,
+			outgoingChannel: action.OutgoingChannel,
+		}
+
+		// The age of the htlc is the time that it spent in the queue.
+		rpcActions[i] = &circuitbreakerrpc.QueueActionRecord{
+			Id:          action.ID,
+			TimestampNs: action.Time.UnixNano(),
+			Node:        action.Peer.String(),
+			Action:      marshalQueueAction(action.Action),
+			Htlc: marshalPeerHtlcs(
+				[]*htlcSnapshot{htlc}, action.Time,
+			)[0],
+			Caller: action.Caller,
+		}
+	}
+
+	return &circuitbreakerrpc.ListQueueActionsResponse{
+		Actions: rpcActions,
+	}, nil
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightningequipment/circuitbreaker/circuitbreakerrpc"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestQueueActions(t *testing.T) {
+	defer Timeout()()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db, cleanup := setupTestDb(t, defaultFwdHistoryLimit)
+	defer cleanup()
+
+	require.NoError(t, db.InitNode(ctx, mockIdentity, false))
+
+	peer := route.Vertex{2}
+	client := newLndclientMock(testChannels, nil)
+	log := zaptest.NewLogger(t).Sugar()
+	p := NewProcess(client, log, &Limits{
+		PerPeer: map[route.Vertex]Limit{
+			peer: {MaxPending: 1, Mode: ModeQueue},
+		},
+	}, db)
+	p.allowUnsafeQueue = true
+
+	s := NewServer(log, []*lndNode{newLndNode(mockIdentity, client, p)}, db)
+
+	sub, cancelSub := p.decisions.subscribe()
+	defer cancelSub()
+
+	exit := make(chan error)
+	go func() {
+		exit <- p.Run(ctx)
+	}()
+
+	// Collect the responses to the interceptor, so that the queue actions
+	// don't block on them.
+	responses := make(chan *interceptResponse, 10)
+	go func() {
+		for {
+			select {
+			case resp := <-client.htlcInterceptorResponses:
+				responses <- resp
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// The first htlc is forwarded, the others are queued.
+	keys := make([]circuitKey, 4)
+	for i := range keys {
+		keys[i] = circuitKey{channel: 2, htlc: uint64(i)}
+		client.htlcInterceptorRequests <- &interceptedEvent{
+			circuitKey:      keys[i],
+			incomingMsat:    2000,
+			outgoingMsat:    1900,
+			outgoingChannel: 4,
+		}
+	}
+	require.Equal(t, keys[0], (<-responses).key)
+
+	listQueued := func() []*circuitbreakerrpc.PeerQueue {
+		resp, err := s.ListQueuedHtlcs(
+			ctx, &circuitbreakerrpc.ListQueuedHtlcsRequest{},
+		)
+		require.NoError(t, err)
+
+		return resp.Queues
+	}
+
+	require.Eventually(t, func() bool {
+		queues := listQueued()
+
+		return len(queues) == 1 && len(queues[0].Htlcs) == 3
+	}, time.Second, 10*time.Millisecond)
+
+	queue := listQueued()[0]
+	require.Equal(t, peer.String(), queue.Node)
+	for i, htlc := range queue.Htlcs {
+		require.EqualValues(t, keys[i+1].htlc,
+			htlc.IncomingCircuit.HtlcIndex)
+		require.EqualValues(t, 4, htlc.OutgoingChannel)
+	}
+
+	// Skip the decisions on the htlcs that were intercepted.
+	for range keys {
+		_, _, err := sub.next(ctx)
+		require.NoError(t, err)
+	}
+
+	rpcKey := func(key circuitKey) *circuitbreakerrpc.CircuitKey {
+		return &circuitbreakerrpc.CircuitKey{
+			ShortChannelId: key.channel,
+			HtlcIndex:      uint32(key.htlc),
+		}
+	}
+
+	// Fail the htlc in the middle of the queue. The action is completed and
+	// recorded, even if the request is cancelled.
+	reqCtx, cancelReq := context.WithCancel(ctx)
+	cancelReq()
+
+	_, err := s.ResolveQueuedHtlc(reqCtx, &circuitbreakerrpc.ResolveQueuedHtlcRequest{
+		IncomingCircuit: rpcKey(keys[2]),
+		Action:          circuitbreakerrpc.QueueAction_QUEUE_ACTION_FAIL,
+	})
+	require.NoError(t, err)
+
+	resp := <-responses
+	require.Equal(t, keys[2], resp.key)
+	require.False(t, resp.resume)
+
+	decision, _, err := sub.next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, decisionReject, decision.decision)
+	require.Equal(t, reasonOperator, decision.reason)
+	require.True(t, decision.operator)
+
+	// Htlcs that are not queued can't be resolved.
+	_, err = s.ResolveQueuedHtlc(ctx, &circuitbreakerrpc.ResolveQueuedHtlcRequest{
+		IncomingCircuit: rpcKey(keys[0]),
+		Action:          circuitbreakerrpc.QueueAction_QUEUE_ACTION_FAIL,
+	})
+	require.ErrorIs(t, err, errQueuedHtlcNotFound)
+
+	_, err = s.ResolveQueuedHtlc(ctx, &circuitbreakerrpc.ResolveQueuedHtlcRequest{
+		IncomingCircuit: &circuitbreakerrpc.CircuitKey{ShortChannelId: 99},
+		Action:          circuitbreakerrpc.QueueAction_QUEUE_ACTION_FAIL,
+	})
+	require.ErrorIs(t, err, errChannelNotFound)
+
+	_, err = s.FlushQueue(ctx, &circuitbreakerrpc.FlushQueueRequest{
+		Node: peer.String(),
+	})
+	require.Error(t, err)
+
+	// Forward the remaining htlcs, regardless of the pending limit.
+	flushResp, err := s.FlushQueue(ctx, &circuitbreakerrpc.FlushQueueRequest{
+		Node:   peer.String(),
+		Action: circuitbreakerrpc.QueueAction_QUEUE_ACTION_FORWARD,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, flushResp.Resolved)
+
+	for _, key := range []circuitKey{keys[1], keys[3]} {
+		resp := <-responses
+		require.Equal(t, key, resp.key)
+		require.True(t, resp.resume)
+
+		decision, _, err := sub.next(ctx)
+		require.NoError(t, err)
+		require.Equal(t, decisionForward, decision.decision)
+		require.True(t, decision.dequeued)
+		require.True(t, decision.operator)
+	}
+
+	require.Empty(t, listQueued())
+
+	// Flushing an empty queue does nothing.
+	flushResp, err = s.FlushQueue(ctx, &circuitbreakerrpc.FlushQueueRequest{
+		Node:   peer.String(),
+		Action: circuitbreakerrpc.QueueAction_QUEUE_ACTION_FAIL,
+	})
+	require.NoError(t, err)
+	require.Zero(t, flushResp.Resolved)
+
+	// All actions are recorded, most recent first.
+	actionsResp, err := s.ListQueueActions(
+		ctx, &circuitbreakerrpc.ListQueueActionsRequest{},
+	)
+	require.NoError(t, err)
+
+	actions := actionsResp.Actions
+	require.Len(t, actions, 3)
+
+	expected := []struct {
+		key    circuitKey
+		action circuitbreakerrpc.QueueAction
+	}{
+		{keys[3], circuitbreakerrpc.QueueAction_QUEUE_ACTION_FORWARD},
+		{keys[1], circuitbreakerrpc.QueueAction_QUEUE_ACTION_FORWARD},
+		{keys[2], circuitbreakerrpc.QueueAction_QUEUE_ACTION_FAIL},
+	}
+	for i, action := range actions {
+		require.Equal(t, peer.String(), action.Node)
+		require.Equal(t, expected[i].action, action.Action)
+		require.EqualValues(t, expected[i].key.htlc,
+			action.Htlc.IncomingCircuit.HtlcIndex)
+		require.EqualValues(t, 2000, action.Htlc.IncomingAmount)
+		require.NotZero(t, action.Htlc.SinceNs)
+		require.Equal(t, "unknown", action.Caller)
+	}
+
+	actionsResp, err = s.ListQueueActions(
+		ctx, &circuitbreakerrpc.ListQueueActionsRequest{
+			Node:  route.Vertex{3}.String(),
+			Limit: 1,
+		},
+	)
+	require.NoError(t, err)
+	require.Empty(t, actionsResp.Actions)
+
+	cancel()
+	require.ErrorIs(t, <-exit, context.Canceled)
+}
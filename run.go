@@ -2,8 +2,10 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"embed"
 	"errors"
+	"fmt"
 	"io/fs"
 	"net"
 	"net/http"
@@ -14,28 +16,107 @@ import (
 	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/lightningequipment/circuitbreaker/circuitbreakerrpc"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/urfave/cli"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/test/bufconn"
 )
 
 var errUserExit = errors.New("user requested termination")
 
 // maxGrpcMsgSize is used when we configure both server and clients to allow sending and
-// receiving at most 32 MB GRPC messages.
+// receiving at most 8 MB GRPC messages.
 //
-// This value is based on the default number of forwarding history entries that we'll
-// store in the database, as this is the largest query we currently make (~13 MB of data)
-// plus some leeway for nodes that override this default to a larger value.
-const maxGrpcMsgSize = 32 * 1024 * 1024
+// This value is based on the largest page of forwarding history that we'll
+// return (~3 MB of data) plus some leeway for the other list queries.
+const maxGrpcMsgSize = 8 * 1024 * 1024
+
+// gatewayBufferSize is the buffer size of the in-process connection between
+// the gateway and the grpc server.
+const gatewayBufferSize = 1024 * 1024
 
 //go:embed all:webui-build
 var content embed.FS
 
+// openDb opens the database backend that is selected on the command line.
+func openDb(ctx context.Context, c *cli.Context, confDir string) (*Db,
+	error) {
+
+	fwdHistoryLimit := c.GlobalInt("fwdhistorylimit")
+
+	switch backend := dbBackend(c.GlobalString(dbBackendFlag.Name)); backend {
+	case backendSqlite:
+		dbPath := filepath.Join(confDir, dbFn)
+
+		log.Infow("Opening database", "path", dbPath)
+
+		return NewDb(ctx, dbPath, fwdHistoryLimit)
+
+	case backendPostgres:
+		dsn := c.GlobalString(dbDsnFlag.Name)
+		if dsn == "" {
+			return nil, fmt.Errorf("%v required for postgres backend",
+				dbDsnFlag.Name)
+		}
+
+		log.Infow("Opening postgres database")
+
+		return NewPostgresDb(ctx, dsn, fwdHistoryLimit)
+
+	default:
+		return nil, fmt.Errorf("unknown database backend: %v", backend)
+	}
+}
+
+// applyLimitsFile writes the limits file to the database on startup, before
+// the process of the node is created.
+func applyLimitsFile(ctx context.Context, c *cli.Context, db Store,
+	file *limitsFile, client lndclient, info *info) error {
+
+	_, updates, err := limitsFileUpdates(
+		ctx, file, db, info.nodeKey, client, client.getNodeAlias,
+	)
+	if err != nil {
+		return fmt.Errorf("cannot apply limits file to %v: %w",
+			info.nodeKey, err)
+	}
+
+	// The process isn't connected yet, so check queue modes against the
+	// version that lnd reported.
+	compat, _ := newLndCompatibility(info.version)
+	for _, update := range updates {
+		if update.Limit == nil {
+			continue
+		}
+
+		err := checkLimitCompat(
+			*update.Limit, compat, c.Bool(allowUnsafeQueueFlag.Name),
+		)
+		if err != nil {
+			return fmt.Errorf("cannot apply limits file to %v: %w",
+				info.nodeKey, err)
+		}
+	}
+
+	if len(updates) == 0 {
+		return nil
+	}
+
+	log.Infow("Applying limits file", "lndNode", info.nodeKey,
+		"updates", len(updates))
+
+	return db.UpdateLimits(ctx, info.nodeKey, updates, limitsFileCaller)
+}
+
 func run(c *cli.Context) error {
 	ctx := context.Background()
 
@@ -44,14 +125,11 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	dbPath := filepath.Join(confDir, dbFn)
 
 	log.Infow("Circuit Breaker starting", "version", BuildVersion)
 
-	log.Infow("Opening database", "path", dbPath)
-
 	// Open database.
-	db, err := NewDb(ctx, dbPath, c.Int("fwdhistorylimit"))
+	db, err := openDb(ctx, c, confDir)
 	if err != nil {
 		return err
 	}
@@ -62,57 +140,225 @@ func run(c *cli.Context) error {
 		}
 	}()
 
+	retention := c.Duration(fwdHistoryRetentionFlag.Name)
+	if retention < 0 {
+		return fmt.Errorf("%v cannot be negative",
+			fwdHistoryRetentionFlag.Name)
+	}
+
+	shutdownPolicy, err := parseQueueAction(
+		c.String(shutdownQueuePolicyFlag.Name),
+	)
+	if err != nil {
+		return fmt.Errorf("%v: %w", shutdownQueuePolicyFlag.Name, err)
+	}
+
+	shutdownTimeout := c.Duration(shutdownTimeoutFlag.Name)
+	if shutdownTimeout <= 0 {
+		return fmt.Errorf("%v must be positive", shutdownTimeoutFlag.Name)
+	}
+
+	// Load the limits file up front, so that a broken file is reported
+	// before connecting to lnd.
+	var limits *limitsFile
+	limitsPath := c.String(limitsFileFlag.Name)
+	if limitsPath != "" {
+		limits, err = loadLimitsFile(limitsPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	peerLabels := c.Int(metricsPeerLabelsFlag.Name)
+	if peerLabels < 0 {
+		return fmt.Errorf("%v cannot be negative",
+			metricsPeerLabelsFlag.Name)
+	}
+	metrics := newMetrics(peerLabels)
+
+	var tlsConfig *tls.Config
+	if c.Bool(noTLSFlag.Name) {
+		log.Infow("Tls disabled")
+	} else {
+		tlsConfig, err = serverTLSConfig(c, confDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	notifierCfg, err := notifierConfigFromCli(c)
+	if err != nil {
+		return err
+	}
+
+	var notifier *notifier
+	if len(notifierCfg.urls) > 0 {
+		notifier = newNotifier(notifierCfg)
+		notifier.start()
+		defer notifier.stop()
+	}
+
+	var auth *authenticator
+	if c.Bool(noAuthFlag.Name) {
+		log.Infow("Authentication disabled")
+	} else {
+		auth, err = initAuth(confDir)
+		if err != nil {
+			return err
+		}
+	}
+
 	group, ctx := errgroup.WithContext(ctx)
 
 	stub := c.Bool(stubFlag.Name)
-	var client lndclient
+	var clients []lndclient
 	if stub {
 		stubClient := newStubClient(ctx)
 
-		client = stubClient
+		clients = append(clients, stubClient)
 	} else {
 		// First, we'll parse the args from the command.
-		tlsCertPath, macPath, err := extractPathArgs(c)
+		lndCfgs, err := lndConfigsFromCli(c)
+		if err != nil {
+			return err
+		}
+
+		for _, lndCfg := range lndCfgs {
+			lndClient, err := NewLndClient(lndCfg)
+			if err != nil {
+				return err
+			}
+			defer lndClient.Close()
+
+			// Verify that the macaroon grants everything that we need, so
+			// that we don't fail later on with a less descriptive error.
+			// Only missing permissions are fatal. The check itself
+			// needs macaroon:read or the uri permission that
+			// bakemacaroon grants, and without it lnd returns a plain
+			// error.
+			err = lndClient.checkMacaroonPermissions(requiredPermissions)
+			switch {
+			case errors.Is(err, errMissingPermissions):
+				return fmt.Errorf("unable to verify lnd credentials "+
+					"for %v: %w", lndCfg.RpcServer, err)
+
+			case err != nil:
+				log.Warnw("Unable to verify macaroon permissions",
+					"rpcServer", lndCfg.RpcServer, "err", err)
+			}
+
+			clients = append(clients, lndClient)
+		}
+	}
+
+	// Set up a process for every node. Limits and history that were stored
+	// before multiple nodes were supported belong to the first node.
+	nodes := make([]*lndNode, 0, len(clients))
+	for i, client := range clients {
+		info, err := client.getInfo()
+		if err != nil {
+			return err
+		}
+
+		for _, node := range nodes {
+			if node.key == info.nodeKey {
+				return fmt.Errorf("node %v configured more than once",
+					info.nodeKey)
+			}
+		}
+
+		err = db.InitNode(ctx, info.nodeKey, i == 0)
 		if err != nil {
 			return err
 		}
 
-		lndCfg := LndConfig{
-			RpcServer:   c.GlobalString("rpcserver"),
-			TlsCertPath: tlsCertPath,
-			MacPath:     macPath,
-			Log:         log,
+		if limits != nil {
+			err := applyLimitsFile(ctx, c, db, limits, client, info)
+			if err != nil {
+				return err
+			}
 		}
 
-		lndClient, err := NewLndClient(&lndCfg)
+		nodeLimits, err := db.GetLimits(ctx, info.nodeKey)
 		if err != nil {
 			return err
 		}
-		defer lndClient.Close()
 
-		client = lndClient
+		nodeLog := log
+		if len(clients) > 1 {
+			nodeLog = log.With("lndNode", info.nodeKey)
+		}
+
+		p := NewProcess(client, nodeLog, nodeLimits, db)
+		p.allowUnsafeQueue = c.Bool(allowUnsafeQueueFlag.Name)
+		p.requireInterceptor = c.Bool(requireInterceptorFlag.Name)
+		p.metrics = metrics
+		p.notifier = notifier
+
+		nodes = append(nodes, newLndNode(info.nodeKey, client, p))
 	}
 
-	limits, err := db.GetLimits(ctx)
-	if err != nil {
-		return err
+	metrics.registerNodes(nodes)
+
+	grpcMetrics := grpc_prometheus.NewServerMetrics()
+	grpcMetrics.EnableHandlingTimeHistogram()
+	metrics.registry.MustRegister(grpcMetrics)
+
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		grpcMetrics.StreamServerInterceptor(),
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		grpcMetrics.UnaryServerInterceptor(),
+	}
+	if auth != nil {
+		streamInterceptors = append(
+			streamInterceptors, auth.streamServerInterceptor(),
+		)
+		unaryInterceptors = append(
+			unaryInterceptors, auth.unaryServerInterceptor(),
+		)
 	}
 
-	p := NewProcess(client, log, limits, db)
+	// The gateway connects to the grpc server through an in-process
+	// listener, using the same transport security as external clients.
+	serverCreds := insecure.NewCredentials()
+	gatewayCreds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		serverCreds = credentials.NewTLS(tlsConfig)
+		gatewayCreds = pinnedCredentials(tlsConfig)
+	}
 
 	grpcServer := grpc.NewServer(
+		grpc.Creds(serverCreds),
 		grpc.MaxRecvMsgSize(maxGrpcMsgSize),
-		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer()),
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer()),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			streamInterceptors...,
+		)),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			unaryInterceptors...,
+		)),
 	)
 
 	reflection.Register(grpcServer)
 
-	server := NewServer(log, p, client, db)
+	// The health service reports not serving until the first check has
+	// passed.
+	healthServer := health.NewServer()
+	for _, service := range grpcHealthServices {
+		healthServer.SetServingStatus(
+			service, grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+		)
+	}
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+
+	healthChecker := newHealthChecker(log, nodes, db)
+
+	server := NewServer(log, nodes, db)
 
 	circuitbreakerrpc.RegisterServiceServer(
 		grpcServer, server,
 	)
+	grpcMetrics.InitializeMetrics(grpcServer)
 
 	listenAddress := c.String("listen")
 	grpcInternalListener, err := net.Listen("tcp", listenAddress)
@@ -120,12 +366,19 @@ func run(c *cli.Context) error {
 		return err
 	}
 
+	gwListener := gatewayListener{bufconn.Listen(gatewayBufferSize)}
+
 	// Create a client connection to the gRPC server we just started
 	// This is where the gRPC-Gateway proxies the requests
 	conn, err := grpc.DialContext(
 		ctx,
-		listenAddress,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		"bufconn",
+		grpc.WithContextDialer(
+			func(ctx context.Context, _ string) (net.Conn, error) {
+				return gwListener.DialContext(ctx)
+			},
+		),
+		grpc.WithTransportCredentials(gatewayCreds),
 		grpc.WithDefaultCallOptions(
 			grpc.MaxCallRecvMsgSize(maxGrpcMsgSize),
 		),
@@ -135,7 +388,11 @@ func run(c *cli.Context) error {
 	}
 
 	// Create http server.
-	gwmux := runtime.NewServeMux()
+	gwmux := runtime.NewServeMux(
+		runtime.WithMetadata(gatewayMetadata),
+		runtime.WithMetadata(gatewayClientMetadata),
+		runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher),
+	)
 
 	err = circuitbreakerrpc.RegisterServiceHandler(ctx, gwmux, conn)
 	if err != nil {
@@ -150,23 +407,73 @@ func run(c *cli.Context) error {
 	fs := http.FileServer(http.FS(serverRoot))
 	mux := http.NewServeMux()
 	mux.Handle("/api/", http.StripPrefix("/api", gwmux))
+
+	// Handlers outside of the gateway aren't covered by the grpc
+	// interceptors and check the token themselves.
+	requirePermission := func(required permission,
+		handler http.Handler) http.Handler {
+
+		if auth == nil {
+			return handler
+		}
+
+		return auth.httpHandler(required, handler)
+	}
+	mux.Handle("/api/export", requirePermission(
+		permissionRead, newExportHandler(server),
+	))
+	mux.Handle("/api/htlc_decisions", requirePermission(
+		permissionRead, newDecisionsHandler(server),
+	))
+	mux.Handle("/metrics", requirePermission(
+		permissionRead, promhttp.HandlerFor(
+			metrics.registry, promhttp.HandlerOpts{},
+		),
+	))
+	if auth != nil {
+		mux.Handle("/api/login", auth.newLoginHandler())
+	}
+	mux.Handle("/api/session", newSessionHandler(auth))
+	mux.Handle("/api/logout", newLogoutHandler())
+
+	// The probes are used by orchestrators and don't require a token.
+	mux.Handle("/healthz", newHealthHandler(healthChecker, false))
+	mux.Handle("/readyz", newHealthHandler(healthChecker, true))
 	mux.HandleFunc("/", fs.ServeHTTP)
 
 	httpListen := c.String(httpListenFlag.Name)
 	gwServer := &http.Server{
 		Addr:              httpListen,
 		Handler:           mux,
+		TLSConfig:         tlsConfig,
 		ReadHeaderTimeout: time.Second * 10,
 	}
 
-	// Run circuitbreaker core.
+	// Run circuitbreaker core for every node.
+	for _, node := range nodes {
+		p := node.process
+
+		group.Go(func() error {
+			return p.Run(ctx)
+		})
+	}
+
+	// Keep the grpc health service up to date.
 	group.Go(func() error {
-		return p.Run(ctx)
+		return healthChecker.runGrpcHealth(
+			ctx, healthServer, grpcHealthInterval,
+		)
+	})
+
+	// Prune history in the background.
+	group.Go(func() error {
+		return db.RunPruner(ctx, retention, pruneInterval)
 	})
 
 	// Run grpc server.
 	group.Go(func() error {
-		log.Infow("Grpc server starting", "listenAddress", listenAddress)
+		log.Infow("Grpc server starting", "listenAddress", listenAddress,
+			"tls", tlsConfig != nil)
 		err := grpcServer.Serve(grpcInternalListener)
 		if err != nil && err != grpc.ErrServerStopped {
 			log.Errorw("grpc server error", "err", err)
@@ -175,9 +482,25 @@ func run(c *cli.Context) error {
 		return err
 	})
 
+	// Serve the gateway connection.
+	group.Go(func() error {
+		err := grpcServer.Serve(gwListener)
+		if err != nil && err != grpc.ErrServerStopped {
+			log.Errorw("grpc gateway listener error", "err", err)
+		}
+
+		return err
+	})
+
 	// Run http server.
 	group.Go(func() error {
-		log.Infow("HTTP server starting", "listenAddress", httpListen)
+		log.Infow("HTTP server starting", "listenAddress", httpListen,
+			"tls", tlsConfig != nil)
+
+		if tlsConfig != nil {
+			// The certificate is taken from the tls config.
+			return gwServer.ListenAndServeTLS("", "")
+		}
 
 		return gwServer.ListenAndServe()
 	})
@@ -200,6 +523,32 @@ func run(c *cli.Context) error {
 		return nil
 	})
 
+	// Reload the limits file on SIGHUP. A broken file leaves the current
+	// limits in place.
+	if limitsPath != "" {
+		group.Go(func() error {
+			sighup := make(chan os.Signal, 1)
+			signal.Notify(sighup, syscall.SIGHUP)
+
+			for {
+				select {
+				case <-sighup:
+					log.Infow("Reloading limits file",
+						"path", limitsPath)
+
+					err := server.reloadLimitsFile(ctx, limitsPath)
+					if err != nil {
+						log.Errorw("Unable to reload limits file",
+							"err", err)
+					}
+
+				case <-ctx.Done():
+					return nil
+				}
+			}
+		})
+	}
+
 	group.Go(func() error {
 		log.Infof("Press ctrl-c to exit")
 
@@ -208,6 +557,14 @@ func run(c *cli.Context) error {
 
 		select {
 		case <-sigint:
+			// Resolve queued htlcs and save state while the lnd
+			// streams are still open. Returning cancels the
+			// context, which closes the streams.
+			shutdownNodes(
+				ctx, sigint, nodes, shutdownPolicy,
+				shutdownTimeout,
+			)
+
 			return errUserExit
 
 		case <-ctx.Done():
@@ -1,10 +1,13 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
+	"sort"
 	"sync"
 	"time"
 
@@ -13,40 +16,80 @@ import (
 	"go.uber.org/zap"
 )
 
-type server struct {
-	process *process
+// lndNode is one of the lnd nodes that circuitbreaker manages. Every node has
+// its own process instance.
+type lndNode struct {
+	key     route.Vertex
 	lnd     lndclient
-	db      *Db
-	log     *zap.SugaredLogger
+	process *process
 
 	aliases     map[route.Vertex]string
 	aliasesLock sync.Mutex
+}
 
-	circuitbreakerrpc.UnimplementedServiceServer
+func newLndNode(key route.Vertex, lnd lndclient, process *process) *lndNode {
+	return &lndNode{
+		key:     key,
+		lnd:     lnd,
+		process: process,
+		aliases: make(map[route.Vertex]string),
+	}
 }
 
-func NewServer(log *zap.SugaredLogger, process *process,
-	lnd lndclient, db *Db) *server {
+type server struct {
+	// nodes contains the managed lnd nodes in the order in which they were
+	// configured.
+	nodes []*lndNode
+	db    Store
+	log   *zap.SugaredLogger
 
+	circuitbreakerrpc.UnimplementedServiceServer
+}
+
+func NewServer(log *zap.SugaredLogger, nodes []*lndNode, db Store) *server {
 	return &server{
-		process: process,
-		lnd:     lnd,
-		db:      db,
-		log:     log,
-		aliases: make(map[route.Vertex]string),
+		nodes: nodes,
+		db:    db,
+		log:   log,
 	}
 }
 
-func (s *server) getAlias(key route.Vertex) (string, error) {
-	s.aliasesLock.Lock()
-	defer s.aliasesLock.Unlock()
+// getNode returns the node that a request is targeting. The node key can only
+// be omitted if there is a single node.
+func (s *server) getNode(nodeKey string) (*lndNode, error) {
+	if nodeKey == "" {
+		if len(s.nodes) != 1 {
+			return nil, errors.New("node key required when managing " +
+				"multiple nodes")
+		}
+
+		return s.nodes[0], nil
+	}
 
-	alias, ok := s.aliases[key]
+	key, err := route.NewVertexFromStr(nodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, node := range s.nodes {
+		if node.key == key {
+			return node, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown node %v", key)
+}
+
+func (s *server) getAlias(node *lndNode, key route.Vertex) (string, error) {
+	node.aliasesLock.Lock()
+	defer node.aliasesLock.Unlock()
+
+	alias, ok := node.aliases[key]
 	if ok {
 		return alias, nil
 	}
 
-	alias, err := s.lnd.getNodeAlias(key)
+	alias, err := node.lnd.getNodeAlias(key)
 	switch {
 	case err == ErrNodeNotFound:
 
@@ -54,26 +97,110 @@ func (s *server) getAlias(key route.Vertex) (string, error) {
 		return "", err
 	}
 
-	s.aliases[key] = alias
+	node.aliases[key] = alias
 
 	return alias, nil
 }
 
+func marshalCounter(count rateCounts) *circuitbreakerrpc.Counter {
+	return &circuitbreakerrpc.Counter{
+		Success: count.success,
+		Fail:    count.fail,
+		Reject:  count.reject,
+	}
+}
+
+func (s *server) GetOverview(ctx context.Context,
+	req *circuitbreakerrpc.GetOverviewRequest) (
+	*circuitbreakerrpc.GetOverviewResponse, error) {
+
+	var (
+		resp   = &circuitbreakerrpc.GetOverviewResponse{}
+		totals = make([]rateCounts, len(rateCounterIntervals))
+	)
+
+	for _, node := range s.nodes {
+		info, err := node.lnd.getInfo()
+		if err != nil {
+			return nil, err
+		}
+
+		counters, err := node.process.getRateCounters(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		nodeCounts := make([]rateCounts, len(rateCounterIntervals))
+		overview := &circuitbreakerrpc.NodeOverview{
+			NodeKey:     hex.EncodeToString(info.nodeKey[:]),
+			NodeAlias:   info.alias,
+			NodeVersion: info.version,
+			PeerCount:   int64(len(counters)),
+		}
+
+		for _, state := range counters {
+			for i, count := range state.counts {
+				nodeCounts[i].success += count.success
+				nodeCounts[i].fail += count.fail
+				nodeCounts[i].reject += count.reject
+			}
+
+			overview.QueueLen += state.queueLen
+			overview.PendingHtlcCount += state.pendingHtlcCount
+		}
+
+		overview.Counter_1H = marshalCounter(nodeCounts[0])
+		overview.Counter_24H = marshalCounter(nodeCounts[1])
+
+		for i, count := range nodeCounts {
+			totals[i].success += count.success
+			totals[i].fail += count.fail
+			totals[i].reject += count.reject
+		}
+
+		resp.QueueLen += overview.QueueLen
+		resp.PendingHtlcCount += overview.PendingHtlcCount
+		resp.Nodes = append(resp.Nodes, overview)
+	}
+
+	resp.Counter_1H = marshalCounter(totals[0])
+	resp.Counter_24H = marshalCounter(totals[1])
+
+	return resp, nil
+}
+
 func (s *server) GetInfo(ctx context.Context,
 	req *circuitbreakerrpc.GetInfoRequest) (*circuitbreakerrpc.GetInfoResponse,
 	error) {
 
-	info, err := s.lnd.getInfo()
+	node, err := s.getNode(req.NodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := node.lnd.getInfo()
 	if err != nil {
 		return nil, err
 	}
 
+	// Report the compatibility as determined when the process connected to
+	// lnd.
+	var autoFailSupported bool
+	if compat := node.process.getCompatibility(); compat != nil {
+		autoFailSupported = compat.supports(featureHtlcAutoFail)
+	}
+
 	return &circuitbreakerrpc.GetInfoResponse{
 		NodeKey:     hex.EncodeToString(info.nodeKey[:]),
 		NodeVersion: info.version,
 		NodeAlias:   info.alias,
 
 		Version: BuildVersion,
+
+		HtlcAutoFailSupported: autoFailSupported,
+		QueueModesAllowed: autoFailSupported ||
+			node.process.allowUnsafeQueue,
+		InterceptorRequired: node.process.isInterceptorRequired(),
 	}, nil
 }
 
@@ -103,12 +230,60 @@ func unmarshalLimit(rpcLimit *circuitbreakerrpc.Limit) (Limit, error) {
 	return limit, nil
 }
 
+// limitHandoffTimeout is the time that the process may take to accept a batch
+// of limit updates that has been committed to the database.
+const limitHandoffTimeout = 10 * time.Second
+
+// updateLimits applies a batch of validated limit updates. The updates are
+// written to the database in a single transaction and only passed on to the
+// process as a single batch once that transaction has committed, so that a
+// failure leaves both unchanged.
+func (s *server) updateLimits(ctx context.Context, lndNode *lndNode,
+	updates []LimitUpdate, caller string) error {
+
+	// Apply the updates in a deterministic order.
+	sort.Slice(updates, func(i, j int) bool {
+		return bytes.Compare(updates[i].Peer[:], updates[j].Peer[:]) < 0
+	})
+
+	for _, update := range updates {
+		s.log.Infow("Updating limit", "lndNode", lndNode.key,
+			"node", update.Peer, "limit", update.Limit)
+	}
+
+	err := s.db.UpdateLimits(ctx, lndNode.key, updates, caller)
+	if err != nil {
+		return err
+	}
+
+	return s.handOffLimits(lndNode, updates)
+}
+
+// handOffLimits passes limit updates that have been committed to the database
+// on to the process.
+func (s *server) handOffLimits(lndNode *lndNode, updates []LimitUpdate) error {
+	// Once committed, the updates must reach the process, even if the
+	// caller goes away in the meantime. Detach from the cancellation of the
+	// request.
+	ctx, cancel := context.WithTimeout(
+		context.Background(), limitHandoffTimeout,
+	)
+	defer cancel()
+
+	return lndNode.process.UpdateLimits(ctx, updates)
+}
+
 func (s *server) UpdateLimits(ctx context.Context,
 	req *circuitbreakerrpc.UpdateLimitsRequest) (
 	*circuitbreakerrpc.UpdateLimitsResponse, error) {
 
+	lndNode, err := s.getNode(req.NodeKey)
+	if err != nil {
+		return nil, err
+	}
+
 	// Parse and validate request.
-	limits := make(map[route.Vertex]Limit)
+	updates := make([]LimitUpdate, 0, len(req.Limits))
 	for nodeStr, rpcLimit := range req.Limits {
 		node, err := route.NewVertexFromStr(nodeStr)
 		if err != nil {
@@ -129,22 +304,16 @@ func (s *server) UpdateLimits(ctx context.Context,
 			return nil, err
 		}
 
-		limits[node] = limit
-	}
-
-	// Apply limits.
-	for node, limit := range limits {
-		node, limit := node, limit
-
-		s.log.Infow("Updating limit", "node", node, "limit", limit)
-
-		if err := s.db.UpdateLimit(ctx, node, limit); err != nil {
+		if err := lndNode.process.checkLimit(limit); err != nil {
 			return nil, err
 		}
 
-		if err := s.process.UpdateLimit(ctx, &node, &limit); err != nil {
-			return nil, err
-		}
+		updates = append(updates, LimitUpdate{Peer: node, Limit: &limit})
+	}
+
+	err = s.updateLimits(ctx, lndNode, updates, callerIdentity(ctx))
+	if err != nil {
+		return nil, err
 	}
 
 	return &circuitbreakerrpc.UpdateLimitsResponse{}, nil
@@ -154,23 +323,28 @@ func (s *server) ClearLimits(ctx context.Context,
 	req *circuitbreakerrpc.ClearLimitsRequest) (
 	*circuitbreakerrpc.ClearLimitsResponse, error) {
 
+	lndNode, err := s.getNode(req.NodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]LimitUpdate, 0, len(req.Nodes))
 	for _, nodeStr := range req.Nodes {
 		node, err := route.NewVertexFromStr(nodeStr)
 		if err != nil {
 			return nil, err
 		}
 
-		s.log.Infow("Clearing limit", "node", node)
-
-		err = s.db.ClearLimit(ctx, node)
-		if err != nil {
-			return nil, err
+		if node == defaultNodeKey {
+			return nil, errors.New("cannot clear default limit")
 		}
 
-		err = s.process.UpdateLimit(ctx, &node, nil)
-		if err != nil {
-			return nil, err
-		}
+		updates = append(updates, LimitUpdate{Peer: node})
+	}
+
+	err = s.updateLimits(ctx, lndNode, updates, callerIdentity(ctx))
+	if err != nil {
+		return nil, err
 	}
 
 	return &circuitbreakerrpc.ClearLimitsResponse{}, nil
@@ -180,19 +354,24 @@ func (s *server) UpdateDefaultLimit(ctx context.Context,
 	req *circuitbreakerrpc.UpdateDefaultLimitRequest) (
 	*circuitbreakerrpc.UpdateDefaultLimitResponse, error) {
 
-	limit, err := unmarshalLimit(req.Limit)
+	lndNode, err := s.getNode(req.NodeKey)
 	if err != nil {
 		return nil, err
 	}
 
-	s.log.Infow("Updating default limit", "limit", limit)
-
-	err = s.db.UpdateLimit(ctx, defaultNodeKey, limit)
+	limit, err := unmarshalLimit(req.Limit)
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.process.UpdateLimit(ctx, nil, &limit)
+	if err := lndNode.process.checkLimit(limit); err != nil {
+		return nil, err
+	}
+
+	err = s.updateLimits(
+		ctx, lndNode, []LimitUpdate{{Peer: defaultNodeKey, Limit: &limit}},
+		callerIdentity(ctx),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -230,22 +409,19 @@ func (s *server) ListLimits(ctx context.Context,
 	req *circuitbreakerrpc.ListLimitsRequest) (
 	*circuitbreakerrpc.ListLimitsResponse, error) {
 
-	limits, err := s.db.GetLimits(ctx)
+	lndNode, err := s.getNode(req.NodeKey)
 	if err != nil {
 		return nil, err
 	}
 
-	counters, err := s.process.getRateCounters(ctx)
+	limits, err := s.db.GetLimits(ctx, lndNode.key)
 	if err != nil {
 		return nil, err
 	}
 
-	marshalCounter := func(count rateCounts) *circuitbreakerrpc.Counter {
-		return &circuitbreakerrpc.Counter{
-			Success: count.success,
-			Fail:    count.fail,
-			Reject:  count.reject,
-		}
+	counters, err := lndNode.process.getRateCounters(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	var rpcLimits = []*circuitbreakerrpc.NodeLimit{}
@@ -253,7 +429,7 @@ func (s *server) ListLimits(ctx context.Context,
 	createRpcState := func(peer route.Vertex, state *peerState) (
 		*circuitbreakerrpc.NodeLimit, error) {
 
-		alias, err := s.getAlias(peer)
+		alias, err := s.getAlias(lndNode, peer)
 		if err != nil {
 			return nil, err
 		}
@@ -313,36 +489,460 @@ func (s *server) ListLimits(ctx context.Context,
 	}, nil
 }
 
-func (s *server) ListForwardingHistory(ctx context.Context,
-	req *circuitbreakerrpc.ListForwardingHistoryRequest) (
-	*circuitbreakerrpc.ListForwardingHistoryResponse, error) {
+func (s *server) GetPeerDetail(ctx context.Context,
+	req *circuitbreakerrpc.GetPeerDetailRequest) (
+	*circuitbreakerrpc.GetPeerDetailResponse, error) {
+
+	lndNode, err := s.getNode(req.NodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	peer, err := route.NewVertexFromStr(req.Node)
+	if err != nil {
+		return nil, err
+	}
+
+	windows, err := parseStatsWindows(req.WindowSecs)
+	if err != nil {
+		return nil, err
+	}
+
+	alias, err := s.getAlias(lndNode, peer)
+	if err != nil {
+		return nil, err
+	}
+
+	channels, err := s.getPeerChannels(lndNode, peer)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, err := s.db.GetLimits(ctx, lndNode.key)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, ok := limits.PerPeer[peer]
+	limitSource := circuitbreakerrpc.LimitSource_LIMIT_SOURCE_PEER
+	if !ok {
+		limit = limits.Default
+		limitSource = circuitbreakerrpc.LimitSource_LIMIT_SOURCE_DEFAULT
+	}
+
+	// Peers without a controller haven't forwarded any htlcs yet. Their
+	// limiter is full.
+	state, err := lndNode.process.getPeerState(ctx, peer)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		state = &peerState{
+			counts: make([]rateCounts, len(rateCounterIntervals)),
+			limit:  limit,
+			tokens: float64(lndNode.process.burstSize),
+		}
+	}
+
+	rpcLimit, err := marshalLimit(state.limit)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	stats, err := s.getPeerStats(ctx, lndNode, peer, windows, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &circuitbreakerrpc.GetPeerDetailResponse{
+		Node:          peer.String(),
+		Alias:         alias,
+		Channels:      channels,
+		Limit:         rpcLimit,
+		LimitSource:   limitSource,
+		LimiterTokens: state.tokens,
+		Counter_1H:    marshalCounter(state.counts[0]),
+		Counter_24H:   marshalCounter(state.counts[1]),
+		PendingHtlcs:  marshalPeerHtlcs(state.pendingHtlcs, now),
+		QueuedHtlcs:   marshalPeerHtlcs(state.queuedHtlcs, now),
+		Stats:         stats,
+	}, nil
+}
+
+// parseStatsWindows converts the statistics windows of a request.
+func parseStatsWindows(windowSecs []uint64) ([]time.Duration, error) {
+	if len(windowSecs) == 0 {
+		return defaultPeerStatsWindows, nil
+	}
 
+	if len(windowSecs) > maxPeerStatsWindows {
+		return nil, fmt.Errorf("at most %v windows can be requested",
+			maxPeerStatsWindows)
+	}
+
+	windows := make([]time.Duration, len(windowSecs))
+	for i, secs := range windowSecs {
+		if secs == 0 {
+			return nil, fmt.Errorf("invalid window: %v seconds", secs)
+		}
+
+		if secs > uint64(maxPeerStatsWindow/time.Second) {
+			return nil, fmt.Errorf("window of %v seconds exceeds "+
+				"maximum of %v", secs, maxPeerStatsWindow)
+		}
+
+		windows[i] = time.Duration(secs) * time.Second
+	}
+
+	return windows, nil
+}
+
+// getPeerChannels returns the open and closed channels with the peer provided,
+// ordered by short channel id.
+func (s *server) getPeerChannels(lndNode *lndNode, peer route.Vertex) (
+	[]*circuitbreakerrpc.PeerChannel, error) {
+
+	openChannels, err := lndNode.lnd.listChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	closedChannels, err := lndNode.lnd.listClosedChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	var rpcChannels []*circuitbreakerrpc.PeerChannel
+	addChannels := func(channels map[uint64]*channel, closed bool) {
+		for chanID, ch := range channels {
+			if ch.peer != peer {
+				continue
+			}
+
+			var state circuitbreakerrpc.ChannelState
+			switch {
+			case closed:
+				state = circuitbreakerrpc.ChannelState_CHANNEL_STATE_CLOSED
+
+			case ch.active:
+				state = circuitbreakerrpc.ChannelState_CHANNEL_STATE_ACTIVE
+
+			default:
+				state = circuitbreakerrpc.ChannelState_CHANNEL_STATE_INACTIVE
+			}
+
+			rpcChannels = append(rpcChannels,
+				&circuitbreakerrpc.PeerChannel{
+					ShortChannelId: chanID,
+					Initiator:      ch.initiator,
+					State:          state,
+				})
+		}
+	}
+
+	addChannels(openChannels, false)
+	addChannels(closedChannels, true)
+
+	sort.Slice(rpcChannels, func(i, j int) bool {
+		return rpcChannels[i].ShortChannelId <
+			rpcChannels[j].ShortChannelId
+	})
+
+	return rpcChannels, nil
+}
+
+// getPeerStats computes the forwarding statistics of a peer from the
+// forwarding history.
+func (s *server) getPeerStats(ctx context.Context, lndNode *lndNode,
+	peer route.Vertex, windows []time.Duration, now time.Time) (
+	[]*circuitbreakerrpc.PeerStats, error) {
+
+	stats, err := s.db.GetPeerStats(ctx, lndNode.key, peer, windows, now)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcStats := make([]*circuitbreakerrpc.PeerStats, len(stats))
+	for i, windowStats := range stats {
+		rpcStats[i] = &circuitbreakerrpc.PeerStats{
+			WindowSecs: uint64(windowStats.window / time.Second),
+			Incoming:   marshalDirectionStats(&windowStats.incoming),
+			Outgoing:   marshalDirectionStats(&windowStats.outgoing),
+		}
+	}
+
+	return rpcStats, nil
+}
+
+func marshalDirectionStats(
+	stats *directionStats) *circuitbreakerrpc.PeerDirectionStats {
+
+	return &circuitbreakerrpc.PeerDirectionStats{
+		SettledCount:  uint64(stats.settled),
+		FailedCount:   uint64(stats.failed),
+		SettleRatio:   stats.settleRatio(),
+		FeesMsat:      uint64(stats.fees),
+		HoldTimeP50Ns: uint64(stats.holdTimeP50),
+		HoldTimeP90Ns: uint64(stats.holdTimeP90),
+		HoldTimeP99Ns: uint64(stats.holdTimeP99),
+	}
+}
+
+func marshalPeerHtlcs(htlcs []*htlcSnapshot,
+	now time.Time) []*circuitbreakerrpc.PeerHtlc {
+
+	rpcHtlcs := make([]*circuitbreakerrpc.PeerHtlc, len(htlcs))
+	for i, htlc := range htlcs {
+		rpcHtlc := &circuitbreakerrpc.PeerHtlc{
+			IncomingCircuit: &circuitbreakerrpc.CircuitKey{
+				ShortChannelId: htlc.channel,
+				HtlcIndex:      uint32(htlc.htlc),
+			},
+			OutgoingChannel: htlc.outgoingChannel,
+			IncomingAmount:  uint64(htlc.incomingMsat),
+			OutgoingAmount:  uint64(htlc.outgoingMsat),
+		}
+
+		if !htlc.since.IsZero() {
+			rpcHtlc.SinceNs = htlc.since.UnixNano()
+
+			if age := now.Sub(htlc.since); age > 0 {
+				rpcHtlc.AgeNs = uint64(age)
+			}
+		}
+
+		rpcHtlcs[i] = rpcHtlc
+	}
+
+	return rpcHtlcs
+}
+
+// parseTimeRange converts a time range in unix nanoseconds as used in requests.
+// By default the range runs from the epoch until now.
+func parseTimeRange(startNs, endNs int64) (time.Time, time.Time, error) {
 	var (
-		// By default query from the epoch until now.
 		startTime = time.Time{}
 		endTime   = time.Now()
 	)
 
-	if req.AddStartTimeNs != 0 {
-		startTime = time.Unix(0, req.AddStartTimeNs)
+	if startNs != 0 {
+		startTime = time.Unix(0, startNs)
 	}
 
-	if req.AddEndTimeNs != 0 {
-		endTime = time.Unix(0, req.AddEndTimeNs)
+	if endNs != 0 {
+		endTime = time.Unix(0, endNs)
 	}
 
 	if startTime.After(endTime) {
-		return nil, fmt.Errorf("start time: %v after end time: %v", startTime,
-			endTime)
+		return time.Time{}, time.Time{}, fmt.Errorf("start time: %v "+
+			"after end time: %v", startTime, endTime)
 	}
 
-	htlcs, err := s.db.ListForwardingHistory(ctx, startTime, endTime)
+	return startTime, endTime, nil
+}
+
+const (
+	// defaultFwdHistoryPageSize is the number of forwards that is returned
+	// if the request doesn't set a limit.
+	defaultFwdHistoryPageSize = 1000
+
+	// maxFwdHistoryPageSize is the maximum number of forwards that is
+	// returned in a single response. Larger limits are reduced to it.
+	maxFwdHistoryPageSize = 10000
+)
+
+// fwdHistoryPageSize returns the number of forwards to return for the limit
+// requested.
+func fwdHistoryPageSize(limit uint32) int {
+	switch {
+	case limit == 0:
+		return defaultFwdHistoryPageSize
+
+	case limit > maxFwdHistoryPageSize:
+		return maxFwdHistoryPageSize
+	}
+
+	return int(limit)
+}
+
+func (s *server) ListForwardingHistory(ctx context.Context,
+	req *circuitbreakerrpc.ListForwardingHistoryRequest) (
+	*circuitbreakerrpc.ListForwardingHistoryResponse, error) {
+
+	lndNode, err := s.getNode(req.NodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime, endTime, err := parseTimeRange(
+		req.AddStartTimeNs, req.AddEndTimeNs,
+	)
 	if err != nil {
 		return nil, err
 	}
 
+	query := &ForwardingHistoryQuery{
+		Start:      startTime,
+		End:        endTime,
+		Channel:    req.Channel,
+		Descending: req.SortOrder == circuitbreakerrpc.SortOrder_SORT_ORDER_DESCENDING,
+		Limit:      fwdHistoryPageSize(req.Limit),
+		Offset:     int(req.Offset),
+	}
+
+	if req.Offset > math.MaxInt32 {
+		return nil, errors.New("offset too large")
+	}
+
+	if req.After != "" {
+		if req.Offset != 0 {
+			return nil, errors.New("offset and after cannot be " +
+				"combined")
+		}
+
+		query.After, err = decodeFwdHistoryCursor(req.After)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	query.IncomingPeer, err = parseOptionalPeer(req.IncomingPeer)
+	if err != nil {
+		return nil, err
+	}
+
+	query.OutgoingPeer, err = parseOptionalPeer(req.OutgoingPeer)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Settled {
+	case circuitbreakerrpc.SettledFilter_SETTLED_FILTER_ALL:
+
+	case circuitbreakerrpc.SettledFilter_SETTLED_FILTER_SETTLED:
+		settled := true
+		query.Settled = &settled
+
+	case circuitbreakerrpc.SettledFilter_SETTLED_FILTER_FAILED:
+		settled := false
+		query.Settled = &settled
+
+	default:
+		return nil, errors.New("unknown settled filter")
+	}
+
+	// Fetch one extra forward to find out whether there is a next page.
+	limit := query.Limit
+	if limit > 0 {
+		query.Limit++
+	}
+
+	htlcs, err := s.db.ListForwardingHistory(ctx, lndNode.key, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextCursor string
+	if limit > 0 && len(htlcs) > limit {
+		htlcs = htlcs[:limit]
+
+		cursor := newFwdHistoryCursor(htlcs[len(htlcs)-1])
+		nextCursor = cursor.encode()
+	}
+
 	return &circuitbreakerrpc.ListForwardingHistoryResponse{
-		Forwards: s.marshalFwdHistory(htlcs),
+		Forwards:   s.marshalFwdHistory(htlcs),
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// parseOptionalPeer parses a peer key that may be left empty.
+func parseOptionalPeer(peerStr string) (*route.Vertex, error) {
+	if peerStr == "" {
+		return nil, nil
+	}
+
+	peer, err := route.NewVertexFromStr(peerStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &peer, nil
+}
+
+func (s *server) GetForwardingStats(ctx context.Context,
+	req *circuitbreakerrpc.GetForwardingStatsRequest) (
+	*circuitbreakerrpc.GetForwardingStatsResponse, error) {
+
+	lndNode, err := s.getNode(req.NodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &ForwardingStatsQuery{
+		// By default query from the epoch until now.
+		Start: time.Time{},
+		End:   time.Now(),
+	}
+
+	switch req.Granularity {
+	case circuitbreakerrpc.Granularity_GRANULARITY_HOUR:
+		query.Granularity = granularityHour
+
+	case circuitbreakerrpc.Granularity_GRANULARITY_DAY:
+		query.Granularity = granularityDay
+
+	default:
+		return nil, errors.New("unknown granularity")
+	}
+
+	if req.StartTimeNs != 0 {
+		query.Start = time.Unix(0, req.StartTimeNs)
+	}
+
+	if req.EndTimeNs != 0 {
+		query.End = time.Unix(0, req.EndTimeNs)
+	}
+
+	if query.Start.After(query.End) {
+		return nil, fmt.Errorf("start time: %v after end time: %v",
+			query.Start, query.End)
+	}
+
+	query.IncomingPeer, err = parseOptionalPeer(req.IncomingPeer)
+	if err != nil {
+		return nil, err
+	}
+
+	query.OutgoingPeer, err = parseOptionalPeer(req.OutgoingPeer)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.db.ListForwardingStats(ctx, lndNode.key, query)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcStats := make([]*circuitbreakerrpc.ForwardingStats, len(stats))
+	for i, stat := range stats {
+		rpcStats[i] = &circuitbreakerrpc.ForwardingStats{
+			BucketStartNs:     stat.BucketStart.UnixNano(),
+			IncomingPeer:      stat.IncomingPeer.String(),
+			OutgoingPeer:      stat.OutgoingPeer.String(),
+			SettledCount:      uint64(stat.SettledCount),
+			FailedCount:       uint64(stat.FailedCount),
+			SettledAmountMsat: uint64(stat.SettledAmt),
+			FeesMsat:          uint64(stat.Fees),
+			HoldTimeNs:        uint64(stat.HoldTime),
+			HoldTimeCount:     uint64(stat.HoldTimeCount),
+			AvgHoldTimeNs:     uint64(stat.AvgHoldTime()),
+		}
+	}
+
+	return &circuitbreakerrpc.GetForwardingStatsResponse{
+		Stats: rpcStats,
 	}, nil
 }
 
@@ -350,6 +950,8 @@ func (s *server) marshalFwdHistory(htlcs []*HtlcInfo) []*circuitbreakerrpc.Forwa
 	rpcHtlcs := make([]*circuitbreakerrpc.Forward, len(htlcs))
 
 	for i, htlc := range htlcs {
+		incomingIndex, outgoingIndex := htlc.htlcIndices()
+
 		forward := &circuitbreakerrpc.Forward{
 			AddTimeNs:      uint64(htlc.addTime.UnixNano()),
 			ResolveTimeNs:  uint64(htlc.resolveTime.UnixNano()),
@@ -359,15 +961,19 @@ func (s *server) marshalFwdHistory(htlcs []*HtlcInfo) []*circuitbreakerrpc.Forwa
 			IncomingPeer:   htlc.incomingPeer.String(),
 			IncomingCircuit: &circuitbreakerrpc.CircuitKey{
 				ShortChannelId: htlc.incomingCircuit.channel,
-				HtlcIndex:      uint32(htlc.incomingCircuit.htlc),
+				HtlcIndex:      uint32(incomingIndex),
 			},
 			OutgoingPeer: htlc.outgoingPeer.String(),
 			OutgoingCircuit: &circuitbreakerrpc.CircuitKey{
 				ShortChannelId: htlc.outgoingCircuit.channel,
-				HtlcIndex:      uint32(htlc.outgoingCircuit.htlc),
+				HtlcIndex:      uint32(outgoingIndex),
 			},
 		}
 
+		if htlc.source == sourceLnd {
+			forward.Source = circuitbreakerrpc.ForwardSource_FORWARD_SOURCE_LND
+		}
+
 		rpcHtlcs[i] = forward
 	}
 
@@ -0,0 +1,638 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/lightningequipment/circuitbreaker/circuitbreakerrpc"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// injectLimitFailure makes the database fail any write of the limit of the
+// peer provided.
+func injectLimitFailure(t *testing.T, db *Db, peer route.Vertex) {
+	peerHex := hex.EncodeToString(peer[:])
+
+	for op, row := range map[string]string{"INSERT": "NEW", "DELETE": "OLD"} {
+		_, err := db.db.Exec(fmt.Sprintf(`CREATE TRIGGER fail_%[1]v_%[3]v
+			BEFORE %[1]v ON limits
+			WHEN %[2]v.peer = '%[3]v'
+			BEGIN
+				SELECT RAISE(ABORT, 'injected failure');
+			END;`, op, row, peerHex))
+		require.NoError(t, err)
+	}
+}
+
+func TestUpdateLimitsBatch(t *testing.T) {
+	defer Timeout()()
+
+	ctx := context.Background()
+
+	db, cleanup := setupTestDb(t, defaultFwdHistoryLimit)
+	defer cleanup()
+
+	require.NoError(t, db.InitNode(ctx, mockIdentity, false))
+
+	log := zaptest.NewLogger(t).Sugar()
+	client := newLndclientMock(testChannels, nil)
+	p := NewProcess(client, log, &Limits{}, db)
+
+	s := NewServer(log, []*lndNode{newLndNode(mockIdentity, client, p)}, db)
+
+	// Capture the batches that are sent to the process.
+	events := make(chan updateLimitEvent, 10)
+	go func() {
+		for event := range p.updateLimitChan {
+			events <- event
+		}
+	}()
+
+	peer1, peer2, peer3 := route.Vertex{1}, route.Vertex{2}, route.Vertex{3}
+	rpcLimit := &circuitbreakerrpc.Limit{
+		MaxHourlyRate: 60,
+		MaxPending:    3,
+		Mode:          circuitbreakerrpc.Mode_MODE_BLOCK,
+	}
+	limit := Limit{MaxHourlyRate: 60, MaxPending: 3, Mode: ModeBlock}
+
+	assertLimits := func(expected map[route.Vertex]Limit) {
+		limits, err := db.GetLimits(ctx, mockIdentity)
+		require.NoError(t, err)
+		require.Equal(t, expected, limits.PerPeer)
+	}
+
+	assertNoBatch := func() {
+		select {
+		case event := <-events:
+			t.Fatalf("unexpected batch: %v", event)
+
+		default:
+		}
+	}
+
+	// Queue modes are rejected as long as the lnd version isn't known.
+	_, err := s.UpdateLimits(ctx, &circuitbreakerrpc.UpdateLimitsRequest{
+		Limits: map[string]*circuitbreakerrpc.Limit{
+			peer1.String(): {Mode: circuitbreakerrpc.Mode_MODE_QUEUE},
+		},
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assertNoBatch()
+
+	// Fail the update of the peer in the middle of the batch.
+	injectLimitFailure(t, db, peer2)
+
+	_, err = s.UpdateLimits(ctx, &circuitbreakerrpc.UpdateLimitsRequest{
+		Limits: map[string]*circuitbreakerrpc.Limit{
+			peer1.String(): rpcLimit,
+			peer2.String(): rpcLimit,
+			peer3.String(): rpcLimit,
+		},
+	})
+	require.ErrorContains(t, err, "injected failure")
+
+	// None of the limits is updated, recorded or passed to the process.
+	assertLimits(map[route.Vertex]Limit{})
+	assertNoBatch()
+
+	changes, err := db.ListLimitChanges(
+		ctx, mockIdentity, &LimitChangesQuery{},
+	)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	// Without the failing peer, the batch is applied in full.
+	_, err = s.UpdateLimits(ctx, &circuitbreakerrpc.UpdateLimitsRequest{
+		Limits: map[string]*circuitbreakerrpc.Limit{
+			peer1.String(): rpcLimit,
+			peer3.String(): rpcLimit,
+		},
+	})
+	require.NoError(t, err)
+
+	assertLimits(map[route.Vertex]Limit{peer1: limit, peer3: limit})
+
+	event := <-events
+	require.Equal(t, []LimitUpdate{
+		{Peer: peer1, Limit: &limit},
+		{Peer: peer3, Limit: &limit},
+	}, event.updates)
+	assertNoBatch()
+
+	// Fail clearing the last peer of a batch.
+	injectLimitFailure(t, db, peer3)
+
+	_, err = s.ClearLimits(ctx, &circuitbreakerrpc.ClearLimitsRequest{
+		Nodes: []string{peer1.String(), peer3.String()},
+	})
+	require.ErrorContains(t, err, "injected failure")
+
+	assertLimits(map[route.Vertex]Limit{peer1: limit, peer3: limit})
+	assertNoBatch()
+
+	// Clearing the default limit is rejected before anything is written.
+	_, err = s.ClearLimits(ctx, &circuitbreakerrpc.ClearLimitsRequest{
+		Nodes: []string{peer1.String(), defaultNodeKey.String()},
+	})
+	require.Error(t, err)
+
+	assertLimits(map[route.Vertex]Limit{peer1: limit, peer3: limit})
+	assertNoBatch()
+}
+
+// TestUpdateLimitsHandoff asserts that limit updates that are committed to the
+// database are passed on to the process, even if the request is cancelled
+// before the process accepts them.
+func TestUpdateLimitsHandoff(t *testing.T) {
+	defer Timeout()()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db, cleanup := setupTestDb(t, defaultFwdHistoryLimit)
+	defer cleanup()
+
+	require.NoError(t, db.InitNode(ctx, mockIdentity, false))
+
+	log := zaptest.NewLogger(t).Sugar()
+	client := newLndclientMock(testChannels, nil)
+	p := NewProcess(client, log, &Limits{}, db)
+
+	s := NewServer(log, []*lndNode{newLndNode(mockIdentity, client, p)}, db)
+
+	peer := route.Vertex{1}
+	limit := Limit{MaxHourlyRate: 60, MaxPending: 3, Mode: ModeBlock}
+
+	// Only accept the batch after the update has been committed and the
+	// request has been cancelled.
+	events := make(chan updateLimitEvent, 1)
+	go func() {
+		require.Eventually(t, func() bool {
+			limits, err := db.GetLimits(
+				context.Background(), mockIdentity,
+			)
+
+			return err == nil && len(limits.PerPeer) == 1
+		}, time.Second, 10*time.Millisecond)
+
+		cancel()
+		time.Sleep(100 * time.Millisecond)
+
+		events <- <-p.updateLimitChan
+	}()
+
+	_, err := s.UpdateLimits(ctx, &circuitbreakerrpc.UpdateLimitsRequest{
+		Limits: map[string]*circuitbreakerrpc.Limit{
+			peer.String(): {
+				MaxHourlyRate: 60,
+				MaxPending:    3,
+				Mode:          circuitbreakerrpc.Mode_MODE_BLOCK,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	event := <-events
+	require.Equal(t, []LimitUpdate{{Peer: peer, Limit: &limit}}, event.updates)
+}
+
+func TestGetPeerDetail(t *testing.T) {
+	defer Timeout()()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db, cleanup := setupTestDb(t, defaultFwdHistoryLimit)
+	defer cleanup()
+
+	require.NoError(t, db.InitNode(ctx, mockIdentity, false))
+
+	peer, otherPeer := route.Vertex{2}, route.Vertex{4}
+
+	client := newLndclientMock(
+		map[uint64]*channel{
+			2: {peer: peer, active: true},
+			5: {peer: peer, initiator: true},
+			4: {peer: otherPeer, active: true},
+		},
+		map[uint64]*channel{
+			6: {peer: peer, initiator: true},
+		},
+	)
+
+	log := zaptest.NewLogger(t).Sugar()
+	limits := &Limits{
+		PerPeer: map[route.Vertex]Limit{
+			peer: {MaxPending: 1, Mode: ModeQueue},
+		},
+	}
+	p := NewProcess(client, log, limits, db)
+	p.allowUnsafeQueue = true
+
+	s := NewServer(log, []*lndNode{newLndNode(mockIdentity, client, p)}, db)
+
+	require.NoError(t, db.UpdateLimits(ctx, mockIdentity, []LimitUpdate{
+		{Peer: peer, Limit: &Limit{MaxPending: 1, Mode: ModeQueue}},
+	}, ""))
+
+	// Record forwards in both directions in the past hour, and one forward
+	// that is older.
+	now := time.Now()
+	for _, htlc := range []*HtlcInfo{
+		{
+			source:          sourceLive,
+			addTime:         now.Add(-time.Minute),
+			resolveTime:     now.Add(-time.Minute + time.Second),
+			settled:         true,
+			incomingMsat:    1100,
+			outgoingMsat:    1000,
+			incomingPeer:    peer,
+			outgoingPeer:    otherPeer,
+			incomingCircuit: circuitKey{channel: 2, htlc: 2},
+			outgoingCircuit: circuitKey{channel: 4, htlc: 2},
+		},
+		{
+			source:          sourceLive,
+			addTime:         now.Add(-2 * time.Minute),
+			resolveTime:     now.Add(-2*time.Minute + time.Second),
+			incomingMsat:    1100,
+			outgoingMsat:    1000,
+			incomingPeer:    otherPeer,
+			outgoingPeer:    peer,
+			incomingCircuit: circuitKey{channel: 4, htlc: 1},
+			outgoingCircuit: circuitKey{channel: 2, htlc: 1},
+		},
+		{
+			source:          sourceLive,
+			addTime:         now.Add(-2 * time.Hour),
+			resolveTime:     now.Add(-2*time.Hour + time.Second),
+			settled:         true,
+			incomingMsat:    1200,
+			outgoingMsat:    1000,
+			incomingPeer:    peer,
+			outgoingPeer:    otherPeer,
+			incomingCircuit: circuitKey{channel: 2, htlc: 1},
+			outgoingCircuit: circuitKey{channel: 4, htlc: 1},
+		},
+	} {
+		require.NoError(t, db.RecordHtlcResolution(ctx, mockIdentity, htlc))
+	}
+
+	exit := make(chan error)
+	go func() {
+		exit <- p.Run(ctx)
+	}()
+
+	getDetail := func(node route.Vertex, windowSecs ...uint64) (
+		*circuitbreakerrpc.GetPeerDetailResponse, error) {
+
+		return s.GetPeerDetail(ctx, &circuitbreakerrpc.GetPeerDetailRequest{
+			Node:       node.String(),
+			WindowSecs: windowSecs,
+		})
+	}
+
+	// The first htlc is forwarded and the second one queued, because only
+	// one htlc may be pending.
+	pendingKey := circuitKey{channel: 2, htlc: 5}
+	client.htlcInterceptorRequests <- &interceptedEvent{
+		circuitKey:      pendingKey,
+		incomingMsat:    2000,
+		outgoingMsat:    1900,
+		outgoingChannel: 4,
+	}
+	require.True(t, (<-client.htlcInterceptorResponses).resume)
+
+	client.htlcInterceptorRequests <- &interceptedEvent{
+		circuitKey:      circuitKey{channel: 5, htlc: 1},
+		incomingMsat:    3000,
+		outgoingMsat:    2900,
+		outgoingChannel: 4,
+	}
+
+	var detail *circuitbreakerrpc.GetPeerDetailResponse
+	require.Eventually(t, func() bool {
+		var err error
+		detail, err = getDetail(peer, 3600, 3*3600)
+		require.NoError(t, err)
+
+		return len(detail.QueuedHtlcs) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	require.Equal(t, peer.String(), detail.Node)
+	require.Equal(t, "alias-"+peer.String()[:6], detail.Alias)
+	require.Equal(t, []*circuitbreakerrpc.PeerChannel{
+		{
+			ShortChannelId: 2,
+			State:          circuitbreakerrpc.ChannelState_CHANNEL_STATE_ACTIVE,
+		},
+		{
+			ShortChannelId: 5,
+			Initiator:      true,
+			State:          circuitbreakerrpc.ChannelState_CHANNEL_STATE_INACTIVE,
+		},
+		{
+			ShortChannelId: 6,
+			Initiator:      true,
+			State:          circuitbreakerrpc.ChannelState_CHANNEL_STATE_CLOSED,
+		},
+	}, detail.Channels)
+
+	require.Equal(t, circuitbreakerrpc.LimitSource_LIMIT_SOURCE_PEER,
+		detail.LimitSource)
+	require.Equal(t, circuitbreakerrpc.Mode_MODE_QUEUE, detail.Limit.Mode)
+	require.EqualValues(t, 1, detail.Limit.MaxPending)
+
+	require.Len(t, detail.PendingHtlcs, 1)
+	pending := detail.PendingHtlcs[0]
+	require.Equal(t, pendingKey.channel,
+		pending.IncomingCircuit.ShortChannelId)
+	require.EqualValues(t, 2000, pending.IncomingAmount)
+	require.NotZero(t, pending.SinceNs)
+
+	queued := detail.QueuedHtlcs[0]
+	require.EqualValues(t, 5, queued.IncomingCircuit.ShortChannelId)
+	require.EqualValues(t, 4, queued.OutgoingChannel)
+	require.EqualValues(t, 2900, queued.OutgoingAmount)
+
+	require.Len(t, detail.Stats, 2)
+
+	hour := detail.Stats[0]
+	require.EqualValues(t, 3600, hour.WindowSecs)
+	require.EqualValues(t, 1, hour.Incoming.SettledCount)
+	require.EqualValues(t, 100, hour.Incoming.FeesMsat)
+	require.EqualValues(t, 1, hour.Incoming.SettleRatio)
+	require.EqualValues(t, time.Second, hour.Incoming.HoldTimeP50Ns)
+	require.EqualValues(t, 1, hour.Outgoing.FailedCount)
+	require.Zero(t, hour.Outgoing.FeesMsat)
+
+	threeHours := detail.Stats[1]
+	require.EqualValues(t, 2, threeHours.Incoming.SettledCount)
+	require.EqualValues(t, 300, threeHours.Incoming.FeesMsat)
+
+	// A peer without htlcs uses the default limit and has a full limiter.
+	detail, err := getDetail(otherPeer)
+	require.NoError(t, err)
+	require.Equal(t, circuitbreakerrpc.LimitSource_LIMIT_SOURCE_DEFAULT,
+		detail.LimitSource)
+	require.Len(t, detail.Stats, len(defaultPeerStatsWindows))
+	require.Empty(t, detail.QueuedHtlcs)
+
+	_, err = getDetail(peer, 0)
+	require.Error(t, err)
+
+	// Windows are capped, so that the history that is aggregated is
+	// bounded.
+	_, err = getDetail(peer, uint64(maxPeerStatsWindow/time.Second)+1)
+	require.ErrorContains(t, err, "exceeds maximum")
+
+	cancel()
+	require.ErrorIs(t, <-exit, context.Canceled)
+}
+
+func TestCallerIdentity(t *testing.T) {
+	require.Equal(t, "unknown", callerIdentity(context.Background()))
+
+	// A direct grpc client is identified by its transport address, even
+	// if it claims to be forwarded.
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 1000},
+	})
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(
+		"x-forwarded-for", "10.0.0.2",
+		gatewayClientKey, "10.0.0.3:3000",
+	))
+	require.Equal(t, "grpc 10.0.0.1:1000", callerIdentity(ctx))
+
+	// Connections of the gateway are marked by its listener.
+	listener := gatewayListener{bufconn.Listen(gatewayBufferSize)}
+	defer listener.Close()
+
+	go func() {
+		_, _ = listener.Dial()
+	}()
+
+	conn, err := listener.Accept()
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// Calls through the gateway are identified by the client address that
+	// the gateway passes on.
+	ctx = peer.NewContext(context.Background(), &peer.Peer{
+		Addr: conn.RemoteAddr(),
+	})
+	ctx = metadata.NewIncomingContext(ctx, gatewayClientMetadata(
+		context.Background(), &http.Request{RemoteAddr: "10.0.0.4:4000"},
+	))
+	require.Equal(t, "rest 10.0.0.4:4000", callerIdentity(ctx))
+
+	// Http clients can't pass on a client address themselves.
+	_, ok := gatewayHeaderMatcher(
+		"Grpc-Metadata-Circuitbreaker-Gateway-Client",
+	)
+	require.False(t, ok)
+
+	key, ok := gatewayHeaderMatcher("Grpc-Metadata-Custom")
+	require.True(t, ok)
+	require.Equal(t, "Custom", key)
+}
+
+func TestListForwardingHistoryCursor(t *testing.T) {
+	ctx := context.Background()
+
+	db, cleanup := setupTestDb(t, defaultFwdHistoryLimit)
+	defer cleanup()
+
+	require.NoError(t, db.InitNode(ctx, mockIdentity, false))
+
+	for i := uint64(1); i <= 3; i++ {
+		htlc := testHtlc(i)
+		require.NoError(t, db.RecordHtlcResolution(ctx, mockIdentity, htlc))
+	}
+
+	client := newLndclientMock(testChannels, nil)
+	s := NewServer(
+		zaptest.NewLogger(t).Sugar(),
+		[]*lndNode{newLndNode(mockIdentity, client, nil)}, db,
+	)
+
+	type listResponse = circuitbreakerrpc.ListForwardingHistoryResponse
+
+	list := func(limit uint32, after string) *listResponse {
+		resp, err := s.ListForwardingHistory(
+			ctx, &circuitbreakerrpc.ListForwardingHistoryRequest{
+				AddEndTimeNs: 100e9,
+				Limit:        limit,
+				After:        after,
+			},
+		)
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	// A page that holds all forwards has no next page.
+	resp := list(3, "")
+	require.Len(t, resp.Forwards, 3)
+	require.Empty(t, resp.NextCursor)
+
+	// A smaller page continues with the remaining forwards.
+	resp = list(2, "")
+	require.Len(t, resp.Forwards, 2)
+	require.NotEmpty(t, resp.NextCursor)
+
+	resp = list(2, resp.NextCursor)
+	require.Len(t, resp.Forwards, 1)
+	require.EqualValues(t, 3, resp.Forwards[0].IncomingCircuit.HtlcIndex)
+	require.Empty(t, resp.NextCursor)
+
+	// Without a limit, a single page is returned.
+	resp = list(0, "")
+	require.Len(t, resp.Forwards, 3)
+
+	require.Equal(t, defaultFwdHistoryPageSize, fwdHistoryPageSize(0))
+	require.Equal(t, 5, fwdHistoryPageSize(5))
+	require.Equal(
+		t, maxFwdHistoryPageSize,
+		fwdHistoryPageSize(maxFwdHistoryPageSize+1),
+	)
+}
+
+func TestRevertLimit(t *testing.T) {
+	defer Timeout()()
+
+	ctx := context.Background()
+
+	db, cleanup := setupTestDb(t, defaultFwdHistoryLimit)
+	defer cleanup()
+
+	require.NoError(t, db.InitNode(ctx, mockIdentity, false))
+
+	log := zaptest.NewLogger(t).Sugar()
+	client := newLndclientMock(testChannels, nil)
+	p := NewProcess(client, log, &Limits{}, db)
+
+	s := NewServer(log, []*lndNode{newLndNode(mockIdentity, client, p)}, db)
+
+	// Drain the updates that are sent to the process.
+	go func() {
+		for range p.updateLimitChan {
+		}
+	}()
+
+	peer := route.Vertex{1}
+	getLimit := func() *Limit {
+		limits, err := db.GetLimits(ctx, mockIdentity)
+		require.NoError(t, err)
+
+		limit, ok := limits.PerPeer[peer]
+		if !ok {
+			return nil
+		}
+
+		return &limit
+	}
+
+	// Set a limit and change it afterwards.
+	limits := []Limit{
+		{MaxHourlyRate: 60, Mode: ModeFail},
+		{MaxHourlyRate: 120, Mode: ModeBlock},
+	}
+	for _, limit := range limits {
+		rpcLimit, err := marshalLimit(limit)
+		require.NoError(t, err)
+
+		_, err = s.UpdateLimits(ctx, &circuitbreakerrpc.UpdateLimitsRequest{
+			Limits: map[string]*circuitbreakerrpc.Limit{
+				peer.String(): rpcLimit,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	changes, err := db.ListLimitChanges(
+		ctx, mockIdentity, &LimitChangesQuery{Peer: &peer},
+	)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+
+	revert := func() (*circuitbreakerrpc.RevertLimitResponse, error) {
+		return s.RevertLimit(ctx, &circuitbreakerrpc.RevertLimitRequest{
+			Node: peer.String(),
+		})
+	}
+
+	// Reverting steps back through the changes rather than undoing the
+	// previous revert.
+	resp, err := revert()
+	require.NoError(t, err)
+	require.Equal(t, changes[0].ID, resp.RevertedChangeId)
+	require.Equal(t, &limits[0], getLimit())
+
+	resp, err = revert()
+	require.NoError(t, err)
+	require.Equal(t, changes[1].ID, resp.RevertedChangeId)
+	require.Nil(t, getLimit())
+
+	_, err = revert()
+	require.ErrorContains(t, err, "no limit changes to revert")
+
+	// The reverts are recorded with the change that they reverted.
+	listResp, err := s.ListLimitChanges(
+		ctx, &circuitbreakerrpc.ListLimitChangesRequest{
+			Node: peer.String(),
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, listResp.Changes, 4)
+	require.Equal(t, changes[1].ID, listResp.Changes[0].RevertedChangeId)
+	require.Equal(t, changes[0].ID, listResp.Changes[1].RevertedChangeId)
+	require.Zero(t, listResp.Changes[2].RevertedChangeId)
+
+	// Set the limits again, and restore the first limit without recording
+	// it, like a limit that was set before changes were recorded.
+	for _, limit := range limits {
+		require.NoError(t, db.UpdateLimit(
+			ctx, mockIdentity, peer, limit, "test",
+		))
+	}
+
+	_, err = db.db.Exec(`UPDATE limits SET htlc_max_hourly_rate = 60,
+		mode = 'FAIL' WHERE peer = ?`, hex.EncodeToString(peer[:]))
+	require.NoError(t, err)
+	require.Equal(t, &limits[0], getLimit())
+
+	changes, err = db.ListLimitChanges(
+		ctx, mockIdentity, &LimitChangesQuery{Peer: &peer, Limit: 1},
+	)
+	require.NoError(t, err)
+
+	// Reverting to the current limit is still recorded, so that the next
+	// revert steps further back.
+	resp, err = revert()
+	require.NoError(t, err)
+	require.Equal(t, changes[0].ID, resp.RevertedChangeId)
+	require.Equal(t, &limits[0], getLimit())
+
+	listResp, err = s.ListLimitChanges(
+		ctx, &circuitbreakerrpc.ListLimitChangesRequest{
+			Node: peer.String(),
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, listResp.Changes, 7)
+	require.Equal(t, changes[0].ID, listResp.Changes[0].RevertedChangeId)
+}
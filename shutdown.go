@@ -0,0 +1,299 @@
+package main
+
+import (
+	"context"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/urfave/cli"
+)
+
+const (
+	// defaultShutdownTimeout is the default time that an orderly shutdown
+	// may take before circuitbreaker exits regardless.
+	defaultShutdownTimeout = 10 * time.Second
+
+	// shutdownCaller identifies the shutdown in the queue action history.
+	shutdownCaller = "shutdown"
+)
+
+var (
+	shutdownQueuePolicyFlag = cli.StringFlag{
+		Name: "shutdown.queuepolicy",
+		Usage: "action on queued htlcs when circuitbreaker shuts down: " +
+			"fail or forward",
+		Value: queueActionFail.String(),
+	}
+
+	shutdownTimeoutFlag = cli.DurationFlag{
+		Name: "shutdown.timeout",
+		Usage: "maximum time to resolve queued htlcs and save state on " +
+			"shutdown",
+		Value: defaultShutdownTimeout,
+	}
+)
+
+// shutdownNodes shuts down the processes of all nodes in parallel. Another
+// signal or the timeout aborts the shutdown.
+func shutdownNodes(ctx context.Context, signals <-chan os.Signal,
+	nodes []*lndNode, action queueAction, timeout time.Duration) {
+
+	log.Infow("Shutting down", "queuePolicy", action, "timeout", timeout)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-signals:
+			log.Infow("Shutdown aborted")
+			cancel()
+
+		case <-ctx.Done():
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for _, node := range nodes {
+		node := node
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := node.process.shutdown(ctx, action)
+			if err != nil {
+				log.Errorw("Orderly shutdown failed",
+					"lndNode", node.key, "err", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	log.Infow("Shutdown complete")
+}
+
+// shutdown prepares the process for an orderly exit. Intercepted htlcs are no
+// longer accepted, queued htlcs are resolved with the action provided, and the
+// rate counters and in-flight htlcs are saved to be restored on the next start.
+// The lnd streams stay open until the process is stopped.
+func (p *process) shutdown(ctx context.Context, action queueAction) error {
+	p.lndStateLock.Lock()
+	p.shuttingDown = true
+	running := p.connected && p.htlcEventsActive && p.interceptorActive
+	p.lndStateLock.Unlock()
+
+	// Without a running event loop, there are no queued htlcs and no state
+	// to save.
+	if !running {
+		return nil
+	}
+
+	select {
+	case p.stopInterceptsChan <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	states, err := p.getPeerStates(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	// Keep going if a queue can't be resolved, so that the state is still
+	// saved.
+	var queueErr error
+	for peer, state := range states {
+		if state.queueLen == 0 {
+			continue
+		}
+
+		err := p.flushQueue(ctx, peer, action)
+		if err != nil {
+			p.log.Errorw("Unable to resolve queued htlcs on shutdown",
+				"peer", peer, "err", err)
+
+			if queueErr == nil {
+				queueErr = err
+			}
+		}
+	}
+
+	if err := p.saveState(ctx); err != nil {
+		return err
+	}
+
+	return queueErr
+}
+
+// flushQueue resolves all queued htlcs of a peer on shutdown and records them in
+// the queue action history.
+func (p *process) flushQueue(ctx context.Context, peer route.Vertex,
+	action queueAction) error {
+
+	result, err := p.resolveQueued(ctx, &queueActionRequest{
+		action: action,
+		peer:   peer,
+	})
+	if err != nil {
+		return err
+	}
+
+	records := queueActionRecords(result, action, shutdownCaller, time.Now())
+
+	p.log.Infow("Resolved queued htlcs on shutdown", "peer", peer,
+		"action", action, "count", len(records))
+
+	if len(records) > 0 {
+		err := p.db.RecordQueueActions(ctx, p.identity, records)
+		if err != nil {
+			return err
+		}
+	}
+
+	return result.err
+}
+
+// saveState saves the rate counters and the htlcs that are in flight.
+func (p *process) saveState(ctx context.Context) error {
+	states, err := p.getPeerStates(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	state := &ProcessState{
+		Time: time.Now(),
+	}
+	for peer, peerState := range states {
+		for idx, counts := range peerState.counts {
+			if counts == (rateCounts{}) {
+				continue
+			}
+
+			state.Counters = append(state.Counters, &SavedRateCounter{
+				Peer:     peer,
+				Interval: rateCounterIntervals[idx],
+				Success:  counts.success,
+				Fail:     counts.fail,
+				Reject:   counts.reject,
+			})
+		}
+
+		for _, htlc := range peerState.pendingHtlcs {
+			// The add time and amounts of htlcs that were already
+			// pending on startup may be unknown.
+			if htlc.since.IsZero() {
+				continue
+			}
+
+			state.InFlight = append(state.InFlight, &SavedInFlightHtlc{
+				Peer:            peer,
+				IncomingCircuit: htlc.circuitKey,
+				AddTime:         htlc.since,
+				IncomingMsat:    htlc.incomingMsat,
+				OutgoingMsat:    htlc.outgoingMsat,
+			})
+		}
+	}
+
+	p.log.Infow("Saving state", "counters", len(state.Counters),
+		"inFlightHtlcs", len(state.InFlight))
+
+	return p.db.SaveProcessState(ctx, p.identity, state)
+}
+
+// restoreState restores the state that was saved on the last shutdown. Saved
+// htlcs that are still pending get back their add time and amounts. Rate
+// counts are restored as if they happened on startup, unless the counter
+// interval has fully passed since the shutdown. Because they would then count
+// for a full interval again, they are scaled down by the downtime first.
+func (p *process) restoreState(ctx context.Context,
+	htlcsPerPeer map[route.Vertex]map[circuitKey]*inFlightHtlc) error {
+
+	state, err := p.db.TakeProcessState(ctx, p.identity)
+	if err != nil {
+		return err
+	}
+
+	var restoredHtlcs int
+	for _, htlc := range state.InFlight {
+		// Skip htlcs that were resolved while circuitbreaker was down.
+		pending, ok := htlcsPerPeer[htlc.Peer][htlc.IncomingCircuit]
+		if !ok {
+			continue
+		}
+
+		htlcsPerPeer[htlc.Peer][htlc.IncomingCircuit] = &inFlightHtlc{
+			addedTs:      htlc.AddTime,
+			incomingMsat: htlc.IncomingMsat,
+			outgoingMsat: htlc.OutgoingMsat,
+			held:         pending.held,
+		}
+		restoredHtlcs++
+	}
+
+	downtime := time.Since(state.Time)
+
+	var restoredCounters int
+	for _, counter := range state.Counters {
+		if downtime >= counter.Interval {
+			continue
+		}
+
+		for idx, interval := range rateCounterIntervals {
+			if interval != counter.Interval {
+				continue
+			}
+
+			counts, ok := p.restoredCounts[counter.Peer]
+			if !ok {
+				counts = make([]rateCounts, len(rateCounterIntervals))
+				p.restoredCounts[counter.Peer] = counts
+			}
+
+			counts[idx] = scaleRestoredCounts(rateCounts{
+				success: counter.Success,
+				fail:    counter.Fail,
+				reject:  counter.Reject,
+			}, counter.Interval, downtime)
+			restoredCounters++
+		}
+	}
+
+	if len(state.InFlight) > 0 || len(state.Counters) > 0 {
+		p.log.Infow("Restored state saved on shutdown",
+			"savedAt", state.Time, "inFlightHtlcs", restoredHtlcs,
+			"counters", restoredCounters)
+	}
+
+	return nil
+}
+
+// scaleRestoredCounts scales down saved counts to the share of the counter
+// interval that hasn't passed during the downtime. The times of the individual
+// events aren't saved, so they are assumed to be spread evenly over the
+// interval. This makes restored counts approximate.
+func scaleRestoredCounts(counts rateCounts, interval,
+	downtime time.Duration) rateCounts {
+
+	if downtime <= 0 {
+		return counts
+	}
+	if downtime >= interval {
+		return rateCounts{}
+	}
+
+	remaining := float64(interval-downtime) / float64(interval)
+	scale := func(count int64) int64 {
+		return int64(math.Round(float64(count) * remaining))
+	}
+
+	return rateCounts{
+		success: scale(counts.success),
+		fail:    scale(counts.fail),
+		reject:  scale(counts.reject),
+	}
+}
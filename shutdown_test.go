@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestShutdown(t *testing.T) {
+	defer Timeout()()
+
+	db, cleanup := setupTestDb(t, defaultFwdHistoryLimit)
+	defer cleanup()
+
+	require.NoError(t, db.InitNode(context.Background(), mockIdentity, false))
+
+	peer := route.Vertex{2}
+	limits := &Limits{
+		PerPeer: map[route.Vertex]Limit{
+			peer: {MaxPending: 1, Mode: ModeQueue},
+		},
+	}
+	log := zaptest.NewLogger(t).Sugar()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := newLndclientMock(testChannels, nil)
+	p := NewProcess(client, log, limits, db)
+	p.allowUnsafeQueue = true
+
+	exit := make(chan error)
+	go func() {
+		exit <- p.Run(ctx)
+	}()
+
+	// The first htlc is forwarded, the others are queued.
+	keys := make([]circuitKey, 3)
+	for i := range keys {
+		keys[i] = circuitKey{channel: 2, htlc: uint64(i)}
+		client.htlcInterceptorRequests <- &interceptedEvent{
+			circuitKey:      keys[i],
+			incomingMsat:    2000,
+			outgoingMsat:    1900,
+			outgoingChannel: 4,
+		}
+	}
+
+	resp := <-client.htlcInterceptorResponses
+	require.Equal(t, keys[0], resp.key)
+	require.True(t, resp.resume)
+
+	require.Eventually(t, func() bool {
+		state, err := p.getPeerState(ctx, peer)
+		require.NoError(t, err)
+
+		return state != nil && state.queueLen == 2
+	}, time.Second, 10*time.Millisecond)
+
+	// Shutting down fails the queued htlcs, oldest first.
+	shutdownExit := make(chan error)
+	go func() {
+		shutdownExit <- p.shutdown(ctx, queueActionFail)
+	}()
+
+	for _, key := range keys[1:] {
+		resp := <-client.htlcInterceptorResponses
+		require.Equal(t, key, resp.key)
+		require.False(t, resp.resume)
+	}
+	require.NoError(t, <-shutdownExit)
+	require.True(t, p.getLndHealth().shuttingDown)
+
+	// New htlcs are left to lnd.
+	client.htlcInterceptorRequests <- &interceptedEvent{
+		circuitKey:      circuitKey{channel: 2, htlc: 3},
+		incomingMsat:    2000,
+		outgoingMsat:    1900,
+		outgoingChannel: 4,
+	}
+	select {
+	case resp := <-client.htlcInterceptorResponses:
+		t.Fatalf("unexpected response to htlc %v", resp.key)
+
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	actions, err := db.ListQueueActions(
+		ctx, mockIdentity, &QueueActionsQuery{},
+	)
+	require.NoError(t, err)
+	require.Len(t, actions, 2)
+	for _, action := range actions {
+		require.Equal(t, queueActionFail, action.Action)
+		require.Equal(t, shutdownCaller, action.Caller)
+	}
+
+	cancel()
+	require.ErrorIs(t, <-exit, context.Canceled)
+
+	// On the next start, the forwarded htlc is still pending in lnd. Its
+	// add time and amounts are restored, as are the rate counters.
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	client = newLndclientMock(testChannels, nil)
+	client.pendingHtlcs = map[route.Vertex]map[circuitKey]*inFlightHtlc{
+		peer: {keys[0]: {}},
+	}
+	p = NewProcess(client, log, limits, db)
+	p.allowUnsafeQueue = true
+
+	go func() {
+		exit <- p.Run(ctx)
+	}()
+
+	var state *peerState
+	require.Eventually(t, func() bool {
+		state, err = p.getPeerState(ctx, peer)
+		require.NoError(t, err)
+
+		return state != nil
+	}, time.Second, 10*time.Millisecond)
+
+	require.Len(t, state.pendingHtlcs, 1)
+	pending := state.pendingHtlcs[0]
+	require.Equal(t, keys[0], pending.circuitKey)
+	require.False(t, pending.since.IsZero())
+	require.EqualValues(t, 2000, pending.incomingMsat)
+	require.EqualValues(t, 1900, pending.outgoingMsat)
+
+	for _, counts := range state.counts {
+		require.Equal(t, rateCounts{reject: 2}, counts)
+	}
+
+	// The state is only restored once.
+	saved, err := db.TakeProcessState(ctx, mockIdentity)
+	require.NoError(t, err)
+	require.Empty(t, saved.Counters)
+	require.Empty(t, saved.InFlight)
+
+	cancel()
+	require.ErrorIs(t, <-exit, context.Canceled)
+}
+
+func TestScaleRestoredCounts(t *testing.T) {
+	counts := rateCounts{success: 10, fail: 5, reject: 3}
+
+	require.Equal(t, counts, scaleRestoredCounts(counts, time.Hour, 0))
+
+	require.Equal(t, rateCounts{success: 5, fail: 3, reject: 2},
+		scaleRestoredCounts(counts, time.Hour, 30*time.Minute))
+
+	require.Equal(t, rateCounts{success: 1},
+		scaleRestoredCounts(counts, time.Hour, 57*time.Minute))
+
+	require.Equal(t, rateCounts{},
+		scaleRestoredCounts(counts, time.Hour, time.Hour))
+}
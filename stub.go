@@ -300,9 +300,10 @@ func (s *stubLndClient) generateHtlcs(ctx context.Context, key route.Vertex, pee
 
 		select {
 		case s.interceptRequestChan <- &interceptedEvent{
-			circuitKey:   circuitKeyIn,
-			incomingMsat: lnwire.MilliSatoshi(incomingAmount),
-			outgoingMsat: lnwire.MilliSatoshi(outgoingAmount),
+			circuitKey:      circuitKeyIn,
+			incomingMsat:    lnwire.MilliSatoshi(incomingAmount),
+			outgoingMsat:    lnwire.MilliSatoshi(outgoingAmount),
+			outgoingChannel: circuitKeyOut.channel,
 		}:
 		case <-ctx.Done():
 			return
@@ -342,6 +343,7 @@ func (s *stubLndClient) getChannels(closed bool) map[uint64]*channel {
 			allChannels[chanId] = &channel{
 				peer:      key,
 				initiator: ch.initiator,
+				active:    !ch.closed,
 			}
 		}
 	}
@@ -362,6 +364,14 @@ func (s *stubLndClient) getNodeAlias(key route.Vertex) (string, error) {
 	return peer.alias, nil
 }
 
+// forwardingHistory returns an empty forwarding log, because the stub only
+// generates live traffic.
+func (s *stubLndClient) forwardingHistory(start, end time.Time, offset,
+	maxEvents uint32) ([]*forwardingEvent, uint32, error) {
+
+	return nil, offset, nil
+}
+
 type stubHtlcEventsClient struct {
 	parent *stubLndClient
 }
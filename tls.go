@@ -0,0 +1,291 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/urfave/cli"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// tlsCertFn and tlsKeyFn are the files in the config dir that the
+	// self-signed certificate and its key are written to.
+	tlsCertFn = "tls.cert"
+	tlsKeyFn  = "tls.key"
+
+	// tlsCertValidity is the validity of a self-signed certificate. It
+	// matches the default of lnd.
+	tlsCertValidity = 14 * 30 * 24 * time.Hour
+
+	tlsCertOrganization = "circuitbreaker autogenerated cert"
+)
+
+var (
+	tlsCertPathFlag = cli.StringFlag{
+		Name: "tls.certpath",
+		Usage: "path to the certificate of the grpc and http servers. " +
+			"If not set, a self-signed certificate is generated in " +
+			"the config dir",
+	}
+
+	tlsKeyPathFlag = cli.StringFlag{
+		Name:  "tls.keypath",
+		Usage: "path to the key of the certificate set with tls.certpath",
+	}
+
+	tlsExtraIPFlag = cli.StringSliceFlag{
+		Name: "tls.extraip",
+		Usage: "ip address to add to the self-signed certificate, can " +
+			"be repeated",
+	}
+
+	tlsExtraDomainFlag = cli.StringSliceFlag{
+		Name: "tls.extradomain",
+		Usage: "domain to add to the self-signed certificate, can be " +
+			"repeated",
+	}
+
+	noTLSFlag = cli.BoolFlag{
+		Name:  "notls",
+		Usage: "serve grpc and http without tls",
+	}
+)
+
+// tlsCertConfig describes the self-signed certificate.
+type tlsCertConfig struct {
+	extraIPs     []string
+	extraDomains []string
+}
+
+// certHosts returns the host names and ip addresses that a self-signed
+// certificate is valid for.
+func (c *tlsCertConfig) certHosts() ([]string, []net.IP, error) {
+	domains := []string{"localhost"}
+	if hostname, err := os.Hostname(); err == nil && hostname != "" {
+		domains = append(domains, hostname)
+	}
+	domains = append(domains, c.extraDomains...)
+
+	ips := []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback}
+
+	// Add the addresses of all interfaces, so that the certificate is also
+	// valid when circuitbreaker is accessed over the local network.
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok || ipNet.IP.IsLoopback() {
+			continue
+		}
+
+		ips = append(ips, ipNet.IP)
+	}
+
+	for _, extraIP := range c.extraIPs {
+		ip := net.ParseIP(extraIP)
+		if ip == nil {
+			return nil, nil, fmt.Errorf("invalid ip address %v",
+				extraIP)
+		}
+
+		ips = append(ips, ip)
+	}
+
+	return domains, ips, nil
+}
+
+// genCertPair generates a self-signed certificate and returns the PEM encoded
+// certificate and key.
+func genCertPair(cfg *tlsCertConfig) ([]byte, []byte, error) {
+	domains, ips, err := cfg.certHosts()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
+	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			Organization: []string{tlsCertOrganization},
+			CommonName:   domains[len(domains)-1],
+		},
+		NotBefore: now.Add(-24 * time.Hour),
+		NotAfter:  now.Add(tlsCertValidity),
+
+		KeyUsage: x509.KeyUsageKeyEncipherment |
+			x509.KeyUsageDigitalSignature |
+			x509.KeyUsageCertSign,
+		ExtKeyUsage: []x509.ExtKeyUsage{
+			x509.ExtKeyUsageServerAuth,
+		},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+
+		DNSNames:    domains,
+		IPAddresses: ips,
+	}
+
+	der, err := x509.CreateCertificate(
+		rand.Reader, &template, &template, &key.PublicKey, key,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var certPem, keyPem bytes.Buffer
+	err = pem.Encode(&certPem, &pem.Block{Type: "CERTIFICATE", Bytes: der})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = pem.Encode(&keyPem, &pem.Block{
+		Type: "EC PRIVATE KEY", Bytes: keyDer,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return certPem.Bytes(), keyPem.Bytes(), nil
+}
+
+// loadSelfSignedCert loads the self-signed certificate from the config dir. A
+// new certificate is generated if there is none yet or if it expired.
+func loadSelfSignedCert(confDir string,
+	cfg *tlsCertConfig) (tls.Certificate, error) {
+
+	certPath := filepath.Join(confDir, tlsCertFn)
+	keyPath := filepath.Join(confDir, tlsKeyFn)
+
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	switch {
+	case err == nil:
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			return tls.Certificate{}, err
+		}
+
+		if time.Now().Before(leaf.NotAfter) {
+			return cert, nil
+		}
+
+		log.Infow("Tls certificate expired, generating a new one",
+			"path", certPath)
+
+	case errors.Is(err, os.ErrNotExist):
+		log.Infow("Generating tls certificate", "path", certPath)
+
+	default:
+		return tls.Certificate{}, err
+	}
+
+	certPem, keyPem, err := genCertPair(cfg)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	// Write the key first, so that a certificate is never left without
+	// its key.
+	if err := os.WriteFile(keyPath, keyPem, 0600); err != nil {
+		return tls.Certificate{}, err
+	}
+
+	if err := os.WriteFile(certPath, certPem, 0644); err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return tls.X509KeyPair(certPem, keyPem)
+}
+
+// serverTLSConfig returns the tls config of the grpc and http servers. A
+// certificate provided by the user takes precedence over the self-signed
+// certificate.
+func serverTLSConfig(c *cli.Context, confDir string) (*tls.Config, error) {
+	certPath := c.String(tlsCertPathFlag.Name)
+	keyPath := c.String(tlsKeyPathFlag.Name)
+
+	var (
+		cert tls.Certificate
+		err  error
+	)
+	switch {
+	case certPath != "" && keyPath != "":
+		cert, err = tls.LoadX509KeyPair(certPath, keyPath)
+
+	case certPath != "" || keyPath != "":
+		return nil, fmt.Errorf("%v and %v must be set together",
+			tlsCertPathFlag.Name, tlsKeyPathFlag.Name)
+
+	default:
+		cert, err = loadSelfSignedCert(confDir, &tlsCertConfig{
+			extraIPs:     c.StringSlice(tlsExtraIPFlag.Name),
+			extraDomains: c.StringSlice(tlsExtraDomainFlag.Name),
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot load tls certificate: %w", err)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// pinnedCredentials returns client credentials that only accept the
+// certificate of the server config provided. They are used by the gateway to
+// connect to the grpc server in the same process, whose certificate may not be
+// valid for the address that the gateway dials.
+func pinnedCredentials(serverConfig *tls.Config) credentials.TransportCredentials {
+	serverCert := serverConfig.Certificates[0].Certificate[0]
+
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+
+		// The certificate chain is replaced by an exact match on the
+		// certificate.
+		InsecureSkipVerify: true, //nolint:gosec
+		VerifyPeerCertificate: func(rawCerts [][]byte,
+			_ [][]*x509.Certificate) error {
+
+			if len(rawCerts) == 0 ||
+				!bytes.Equal(rawCerts[0], serverCert) {
+
+				return errors.New("unexpected server certificate")
+			}
+
+			return nil
+		},
+	})
+}
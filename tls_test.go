@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestSelfSignedCert(t *testing.T) {
+	confDir := t.TempDir()
+	cfg := &tlsCertConfig{
+		extraIPs:     []string{"192.0.2.1"},
+		extraDomains: []string{"circuitbreaker.example"},
+	}
+
+	cert, err := loadSelfSignedCert(confDir, cfg)
+	require.NoError(t, err)
+
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	require.NoError(t, leaf.VerifyHostname("localhost"))
+	require.NoError(t, leaf.VerifyHostname("127.0.0.1"))
+	require.NoError(t, leaf.VerifyHostname("192.0.2.1"))
+	require.NoError(t, leaf.VerifyHostname("circuitbreaker.example"))
+
+	info, err := os.Stat(filepath.Join(confDir, tlsKeyFn))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// The certificate is reused on the next start.
+	reloaded, err := loadSelfSignedCert(confDir, cfg)
+	require.NoError(t, err)
+	require.Equal(t, cert.Certificate, reloaded.Certificate)
+
+	_, _, err = genCertPair(&tlsCertConfig{extraIPs: []string{"invalid"}})
+	require.ErrorContains(t, err, "invalid ip address")
+}
+
+func TestPinnedCredentials(t *testing.T) {
+	defer Timeout()()
+
+	newConfig := func() *tls.Config {
+		certPem, keyPem, err := genCertPair(&tlsCertConfig{})
+		require.NoError(t, err)
+
+		cert, err := tls.X509KeyPair(certPem, keyPem)
+		require.NoError(t, err)
+
+		return &tls.Config{Certificates: []tls.Certificate{cert}}
+	}
+
+	serverConfig := newConfig()
+
+	listener := bufconn.Listen(gatewayBufferSize)
+	grpcServer := grpc.NewServer(grpc.Creds(credentials.NewTLS(serverConfig)))
+	grpc_health_v1.RegisterHealthServer(grpcServer, health.NewServer())
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	defer grpcServer.Stop()
+
+	check := func(creds credentials.TransportCredentials) error {
+		ctx := context.Background()
+		conn, err := grpc.DialContext(ctx, "bufconn",
+			grpc.WithContextDialer(
+				func(ctx context.Context, _ string) (net.Conn, error) {
+					return listener.DialContext(ctx)
+				},
+			),
+			grpc.WithTransportCredentials(creds),
+		)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		_, err = grpc_health_v1.NewHealthClient(conn).Check(
+			ctx, &grpc_health_v1.HealthCheckRequest{},
+		)
+
+		return err
+	}
+
+	require.NoError(t, check(pinnedCredentials(serverConfig)))
+
+	// A different certificate is rejected.
+	require.Error(t, check(pinnedCredentials(newConfig())))
+}
@@ -1,2 +1,3 @@
 export { default as useInfo } from './useInfo';
 export { default as useLimits } from './useLimits';
+export { default as useSession } from './useSession';
@@ -13,10 +13,14 @@ const useInfo = () => {
     queryFn: getInfo,
     staleTime: 0,
     retry: false,
-    onError: () =>
+    onError: (error) => {
+      // Unauthenticated requests lead to the login view instead.
+      if (error.response?.status === 401) return;
+
       enqueueSnackbar(tError('error-fetching-info'), {
         variant: 'error',
-      }),
+      });
+    },
   });
 
   return { info: { ...query.data }, ...query };
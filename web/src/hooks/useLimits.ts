@@ -14,10 +14,14 @@ const useLimits = () => {
     staleTime: 0,
     refetchInterval: 30000,
     retry: false,
-    onError: () =>
+    onError: (error) => {
+      // Unauthenticated requests lead to the login view instead.
+      if (error.response?.status === 401) return;
+
       enqueueSnackbar(tError('error-fetching-limits'), {
         variant: 'error',
-      }),
+      });
+    },
   });
 
   return query;
@@ -0,0 +1,19 @@
+import { useQuery } from '@tanstack/react-query';
+import { AxiosError } from 'axios';
+
+import { getSession } from 'services/circuitbreaker';
+
+// useSession returns the role of the logged in user. Controls that change
+// limits are only shown to operators.
+const useSession = () => {
+  const query = useQuery<Session, AxiosError<APIError>>({
+    queryKey: ['session'],
+    queryFn: getSession,
+    staleTime: Infinity,
+    retry: false,
+  });
+
+  return { ...query, isOperator: query.data?.role === 'operator' };
+};
+
+export default useSession;
@@ -4,6 +4,31 @@ const circuitbreakerApi = axios.create({
   baseURL: '/api',
 });
 
+// When circuitbreaker manages multiple lnd nodes, the node to operate on is
+// selected through the `node` query parameter of the page.
+const selectedNode = () =>
+  typeof window === 'undefined'
+    ? null
+    : new URLSearchParams(window.location.search).get('node');
+
+circuitbreakerApi.interceptors.request.use((config) => {
+  const nodeKey = selectedNode();
+  if (!nodeKey) {
+    return config;
+  }
+
+  if (config.method === 'get') {
+    config.params = { ...config.params, nodeKey };
+  } else {
+    config.data = { ...config.data, nodeKey };
+  }
+
+  return config;
+});
+
+export const getOverview = async () =>
+  (await circuitbreakerApi.get<Overview>('/overview')).data;
+
 export const getInfo = async () =>
   (await circuitbreakerApi.get<Info>('/info')).data;
 
@@ -29,3 +54,15 @@ interface UpdateDefaultLimitParams {
 }
 export const updateDefaultLimit = async (params: UpdateDefaultLimitParams) =>
   (await circuitbreakerApi.post<{}>('/updatedefaultlimit', params)).data;
+
+interface LoginParams {
+  token: string;
+}
+export const login = async (params: LoginParams) =>
+  (await circuitbreakerApi.post<Session>('/login', params)).data;
+
+export const getSession = async () =>
+  (await circuitbreakerApi.get<Session>('/session')).data;
+
+export const logout = async () =>
+  (await circuitbreakerApi.post<{}>('/logout')).data;
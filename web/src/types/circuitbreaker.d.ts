@@ -3,6 +3,9 @@ interface Info {
   nodeKey: string;
   nodeAlias: string;
   nodeVersion: string;
+  htlcAutoFailSupported: boolean;
+  queueModesAllowed: boolean;
+  interceptorRequired: boolean;
 }
 
 interface Counter {
@@ -27,6 +30,25 @@ interface NodeLimit {
   pendingHtlcCount: number;
 }
 
+interface NodeOverview {
+  nodeKey: string;
+  nodeAlias: string;
+  nodeVersion: string;
+  peerCount: string;
+  counter1h: Counter;
+  counter24h: Counter;
+  queueLen: string;
+  pendingHtlcCount: string;
+}
+
+interface Overview {
+  nodes: NodeOverview[];
+  counter1h: Counter;
+  counter24h: Counter;
+  queueLen: string;
+  pendingHtlcCount: string;
+}
+
 interface Limits {
   limits: NodeLimit[];
   defaultLimit: Limit;
@@ -48,3 +70,9 @@ type ColumnId =
   | 'limit.mode';
 
 type Order = 'asc' | 'desc';
+
+type Role = 'viewer' | 'operator';
+
+interface Session {
+  role: Role;
+}
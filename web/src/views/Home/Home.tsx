@@ -5,11 +5,13 @@ import { Box } from '@mui/material';
 import { useLimits, useInfo } from 'hooks';
 import { removeLoader } from 'splashScreen';
 
+import Login from '../Login';
+
 import { Header, NodeTable } from './parts';
 
 const Home = () => {
   const { isSuccess: isLimitsSuccess } = useLimits();
-  const { isSuccess: isInfoSuccess } = useInfo();
+  const { isSuccess: isInfoSuccess, error: infoError } = useInfo();
   const { ready } = useTranslation();
 
   const isSuccess = isLimitsSuccess && isInfoSuccess && ready;
@@ -18,6 +20,8 @@ const Home = () => {
     removeLoader();
   }, [isSuccess]);
 
+  if (ready && infoError?.response?.status === 401) return <Login />;
+
   if (!isSuccess) return null;
 
   return (
@@ -6,7 +6,7 @@ import { Box, Typography } from '@mui/material';
 import { enqueueSnackbar } from 'notistack';
 
 import { Mode } from 'enums';
-import { useLimits } from 'hooks';
+import { useLimits, useSession } from 'hooks';
 import { Modal, PrimaryButton, EditLimitsForm } from 'components';
 import { updateDefaultLimit } from 'services/circuitbreaker';
 
@@ -63,6 +63,7 @@ const DefaultLimits = () => {
   const [isModalOpen, setIsModalOpen] = useState(false);
 
   const { data, refetch } = useLimits();
+  const { isOperator } = useSession();
 
   const handleModalClose = () => setIsModalOpen(false);
 
@@ -93,41 +94,46 @@ const DefaultLimits = () => {
           <Config type="max-pending" value={data!.defaultLimit.maxPending} />
           <Config type="mode" value={data!.defaultLimit.mode} />
         </Box>
-        <Box sx={{ display: 'flex', alignItems: 'center' }}>
-          <PrimaryButton onClick={() => setIsModalOpen(true)}>
-            <Box sx={{ display: 'flex', alignItems: 'center' }}>
-              <Box
-                sx={{
-                  mr: 2,
-                  display: 'flex',
-                  img: {
-                    width: { xs: '12px', md: '16px' },
-                    height: { xs: '12px', md: '16px' },
-                  },
-                }}
-              >
-                <Image
-                  src="/icons/edit.svg"
-                  alt="columns"
-                  width={12}
-                  height={12}
-                />
+        {isOperator && (
+          <Box sx={{ display: 'flex', alignItems: 'center' }}>
+            <PrimaryButton onClick={() => setIsModalOpen(true)}>
+              <Box sx={{ display: 'flex', alignItems: 'center' }}>
+                <Box
+                  sx={{
+                    mr: 2,
+                    display: 'flex',
+                    img: {
+                      width: { xs: '12px', md: '16px' },
+                      height: { xs: '12px', md: '16px' },
+                    },
+                  }}
+                >
+                  <Image
+                    src="/icons/edit.svg"
+                    alt="columns"
+                    width={12}
+                    height={12}
+                  />
+                </Box>
+                <Typography
+                  component="span"
+                  sx={{ color: 'inherit', display: { md: 'none' } }}
+                >
+                  {t('edit')}
+                </Typography>
+                <Typography
+                  component="span"
+                  sx={{
+                    color: 'inherit',
+                    display: { xs: 'none', md: 'block' },
+                  }}
+                >
+                  {t('edit-defaults')}
+                </Typography>
               </Box>
-              <Typography
-                component="span"
-                sx={{ color: 'inherit', display: { md: 'none' } }}
-              >
-                {t('edit')}
-              </Typography>
-              <Typography
-                component="span"
-                sx={{ color: 'inherit', display: { xs: 'none', md: 'block' } }}
-              >
-                {t('edit-defaults')}
-              </Typography>
-            </Box>
-          </PrimaryButton>
-        </Box>
+            </PrimaryButton>
+          </Box>
+        )}
       </Box>
       <Modal
         title={t('default-limit-modal.title') || undefined}
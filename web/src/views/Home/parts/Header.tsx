@@ -1,9 +1,11 @@
 import { Box, Typography, Tooltip, IconButton } from '@mui/material';
 import DownloadIcon from '@mui/icons-material/Download';
+import LogoutIcon from '@mui/icons-material/Logout';
 import Image from 'next/image';
 
 import { HEADER_HEIGHT_DESKTOP, HEADER_HEIGHT_MOBILE } from 'constant';
 import { useInfo } from 'hooks';
+import { logout } from 'services/circuitbreaker';
 import { useTranslation } from 'react-i18next';
 
 import NodeInfo from './NodeInfo';
@@ -15,8 +17,18 @@ const Header = () => {
   const { t } = useTranslation();
 
   const openFwdHistory = () => {
-    const url = '/api/forwarding_history'; 
-    window.location.href = url;
+    const params = new URLSearchParams({ format: 'csv' });
+    const node = new URLSearchParams(window.location.search).get('node');
+    if (node) {
+      params.set('node', node);
+    }
+
+    window.location.href = `/api/export?${params}`;
+  };
+
+  const handleLogout = async () => {
+    await logout();
+    window.location.reload();
   };
 
   return (
@@ -79,6 +91,14 @@ const Header = () => {
                 <DownloadIcon sx={{ color: '#5C6484' }}/>
               </IconButton>
             </Tooltip>
+            <Tooltip
+              enterTouchDelay={0}
+              title={<Typography sx={{ color: 'black' }}>{t('logout')}</Typography>}
+            >
+              <IconButton sx={{ padding: 0, mt: 0.5, ml: 2 }} onClick={handleLogout}>
+                <LogoutIcon sx={{ color: '#5C6484' }} />
+              </IconButton>
+            </Tooltip>
           </Box>
         </Box>
       </Box>
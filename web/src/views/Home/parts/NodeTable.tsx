@@ -17,7 +17,7 @@ import {
   Typography,
 } from '@mui/material';
 
-import { useLimits } from 'hooks';
+import { useLimits, useSession } from 'hooks';
 import { Checkbox, NodeAlias } from 'components';
 import { HEADER_HEIGHT_DESKTOP, HEADER_HEIGHT_MOBILE } from 'constant';
 import { Mode } from 'enums';
@@ -210,6 +210,7 @@ const NodeTable = () => {
   const { t } = useTranslation('common', { keyPrefix: 'node-table' });
 
   const { data } = useLimits();
+  const { isOperator } = useSession();
 
   const [page, setPage] = useState(0);
   const [rowsPerPage, setRowsPerPage] = useState(25);
@@ -374,9 +375,11 @@ const NodeTable = () => {
             />
           </Box>
         </Box>
-        <Box>
-          <EditSelectedNodes selected={selected} />
-        </Box>
+        {isOperator && (
+          <Box>
+            <EditSelectedNodes selected={selected} />
+          </Box>
+        )}
       </Box>
       <TableContainer
         sx={{
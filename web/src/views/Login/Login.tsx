@@ -0,0 +1,87 @@
+import { FormEvent, useEffect, useState } from 'react';
+import Image from 'next/image';
+import { useTranslation } from 'react-i18next';
+import { useMutation, useQueryClient } from '@tanstack/react-query';
+import { Box, InputBase, InputLabel, Typography } from '@mui/material';
+import { enqueueSnackbar } from 'notistack';
+
+import { PrimaryButton } from 'components';
+import { login } from 'services/circuitbreaker';
+import { removeLoader } from 'splashScreen';
+
+const Login = () => {
+  const { t } = useTranslation('common', { keyPrefix: 'login' });
+  const { t: tError } = useTranslation('common', { keyPrefix: 'errors' });
+  const [token, setToken] = useState('');
+
+  const queryClient = useQueryClient();
+
+  useEffect(() => {
+    removeLoader();
+  }, []);
+
+  const { mutate, isLoading } = useMutation({
+    mutationFn: login,
+    onSuccess: () => queryClient.resetQueries(),
+    onError: () =>
+      enqueueSnackbar(tError('error-login'), {
+        variant: 'error',
+      }),
+  });
+
+  const handleSubmit = (e: FormEvent) => {
+    e.preventDefault();
+    mutate({ token: token.trim() });
+  };
+
+  return (
+    <Box
+      sx={{
+        height: '100dvh',
+        display: 'flex',
+        alignItems: 'center',
+        justifyContent: 'center',
+        px: 4,
+      }}
+    >
+      <Box
+        component="form"
+        onSubmit={handleSubmit}
+        sx={{ width: '100%', maxWidth: 400 }}
+      >
+        <Box sx={{ display: 'flex', alignItems: 'center', mb: 6 }}>
+          <Box sx={{ mr: 4, img: { display: 'block' } }}>
+            <Image
+              src="/images/circuitbreaker-logo.svg"
+              alt="Circuit Breaker"
+              width={44}
+              height={44}
+            />
+          </Box>
+          <Typography variant="h3" sx={{ color: 'grey.50' }}>
+            Circuit Breaker
+          </Typography>
+        </Box>
+        <InputLabel>{t('token')}</InputLabel>
+        <InputBase
+          fullWidth
+          autoFocus
+          type="password"
+          value={token}
+          onChange={(e) => setToken(e.target.value)}
+          sx={{ mb: 2 }}
+        />
+        <Typography sx={{ color: 'grey.700', mb: 4 }}>{t('hint')}</Typography>
+        <PrimaryButton
+          type="submit"
+          fullWidth
+          disabled={!token.trim() || isLoading}
+        >
+          {t('submit')}
+        </PrimaryButton>
+      </Box>
+    </Box>
+  );
+};
+
+export default Login;
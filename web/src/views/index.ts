@@ -1 +1,2 @@
 export { default as Home } from './Home';
+export { default as Login } from './Login';